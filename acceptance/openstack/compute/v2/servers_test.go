@@ -74,7 +74,7 @@ func createServer(t *testing.T, client *gophercloud.ServiceClient, choices *Comp
 	}
 
 	pager := networks.List(networkingClient, networks.ListOpts{
-		Name:  choices.NetworkName,
+		Name:  []string{choices.NetworkName},
 		Limit: 1,
 	})
 	pager.EachPage(func(page pagination.Page) (bool, error) {
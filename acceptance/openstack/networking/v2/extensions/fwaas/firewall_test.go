@@ -105,11 +105,8 @@ func waitForFirewallToBeActive(t *testing.T, firewallID string) {
 func waitForFirewallToBeDeleted(t *testing.T, firewallID string) {
 	for i := 0; i < 10; i++ {
 		err := firewalls.Get(base.Client, firewallID).Err
-		if err != nil {
-			httpStatus := err.(*gophercloud.UnexpectedResponseCodeError)
-			if httpStatus.Actual == 404 {
-				return
-			}
+		if gophercloud.IsNotFound(err) {
+			return
 		}
 		time.Sleep(time.Second)
 	}
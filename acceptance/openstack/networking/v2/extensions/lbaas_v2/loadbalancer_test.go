@@ -228,11 +228,10 @@ func getLoadbalancerWaitDeleted(t *testing.T, loadbalancerID string) {
 
 		_, err := loadbalancers.Get(base.Client, loadbalancerID).Extract()
 		if err != nil {
-			if errData, ok := err.(*(gophercloud.UnexpectedResponseCodeError)); ok {
-				if errData.Actual == 404 {
-					return
-				}
-			} else {
+			if gophercloud.IsNotFound(err) {
+				return
+			}
+			if _, ok := err.(gophercloud.StatusCodeError); !ok {
 				th.AssertNoErr(t, err)
 			}
 		}
@@ -52,4 +52,31 @@ type AuthOptions struct {
 	// TokenID allows users to authenticate (possibly as another user) with an
 	// authentication token ID.
 	TokenID string
+
+	// Passcode is a time-based one-time password (TOTP) for Identity V3
+	// multi-factor authentication. It's sent along with Password if both are set,
+	// and on its own otherwise. Either way, the user must be identified by UserID
+	// or by Username and a domain.
+	Passcode string
+
+	// PasscodeFunc, if set, is called for a fresh passcode whenever Passcode is
+	// empty, which lets a ProviderClient that's allowed to reauthenticate keep
+	// doing so once the original passcode has expired.
+	PasscodeFunc func() (string, error)
+
+	// ApplicationCredentialID, or ApplicationCredentialName together with
+	// UserID or Username and a domain, identifies an Identity V3 application
+	// credential to authenticate with. ApplicationCredentialSecret is required
+	// with either. Application credentials are bound to a project, so no tenant
+	// may be given.
+	ApplicationCredentialID     string
+	ApplicationCredentialName   string
+	ApplicationCredentialSecret string
+
+	// TokenStore, if set, is consulted before authenticating so that a token
+	// obtained by an earlier process can be reused while it remains valid, and
+	// receives every token obtained by authenticating. Tokens are stored along
+	// with their service catalogs, keyed by identity endpoint, user and scope.
+	// It's ignored when authenticating with TokenID.
+	TokenStore TokenStore
 }
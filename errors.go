@@ -0,0 +1,246 @@
+package gophercloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+)
+
+// UnexpectedResponseCodeError is returned by the Request method when a response code other than
+// those listed in OkCodes is encountered. Responses with a well-known status code are reported as
+// one of the more specific error types below, each of which embeds an UnexpectedResponseCodeError.
+type UnexpectedResponseCodeError struct {
+	URL      string
+	Method   string
+	Expected []int
+	Actual   int
+	Body     []byte
+
+	// RequestID is the identifier the service assigned to the failed request, if it reported one.
+	// Quote it when reporting a problem to the cloud operator.
+	RequestID string
+
+	// Fault is the name of the fault returned by the service, such as "itemNotFound" (Nova),
+	// "NeutronError" (Neutron) or "error" (Keystone). It's empty if the body couldn't be parsed.
+	Fault string
+
+	// Message is the human-readable explanation that accompanied the fault, if any.
+	Message string
+}
+
+func (err *UnexpectedResponseCodeError) Error() string {
+	return fmt.Sprintf(
+		"Expected HTTP response code %v when accessing [%s %s], but got %d instead\n%s",
+		err.Expected, err.Method, err.URL, err.Actual, err.Body,
+	)
+}
+
+// StatusCode returns the HTTP status code of the response that caused the error.
+func (err *UnexpectedResponseCodeError) StatusCode() int {
+	return err.Actual
+}
+
+// StatusCodeError is satisfied by *UnexpectedResponseCodeError and by every typed error that
+// embeds it, so callers can inspect the status of any failed request without a type switch.
+type StatusCodeError interface {
+	error
+	StatusCode() int
+}
+
+func (err *UnexpectedResponseCodeError) responseCodeError() *UnexpectedResponseCodeError {
+	return err
+}
+
+// AsUnexpectedResponseCodeError returns the UnexpectedResponseCodeError behind err, whether err is
+// an *UnexpectedResponseCodeError itself or one of the typed errors that embed it. Code written
+// against releases that only returned *UnexpectedResponseCodeError can use it in place of a type
+// assertion:
+//
+//   if casted, ok := gophercloud.AsUnexpectedResponseCodeError(err); ok && casted.Actual == 404 {
+func AsUnexpectedResponseCodeError(err error) (*UnexpectedResponseCodeError, bool) {
+	casted, ok := err.(interface {
+		responseCodeError() *UnexpectedResponseCodeError
+	})
+	if !ok {
+		return nil, false
+	}
+	return casted.responseCodeError(), true
+}
+
+// BadRequestError is returned for a 400 Bad Request response.
+type BadRequestError struct {
+	UnexpectedResponseCodeError
+}
+
+// UnauthorizedError is returned for a 401 Unauthorized response that couldn't be resolved by
+// re-authenticating.
+type UnauthorizedError struct {
+	UnexpectedResponseCodeError
+}
+
+// ForbiddenError is returned for a 403 Forbidden response.
+type ForbiddenError struct {
+	UnexpectedResponseCodeError
+}
+
+// NotFoundError is returned for a 404 Not Found response.
+type NotFoundError struct {
+	UnexpectedResponseCodeError
+}
+
+// ConflictError is returned for a 409 Conflict response, typically because the resource is in a
+// state that doesn't allow the requested operation.
+type ConflictError struct {
+	UnexpectedResponseCodeError
+}
+
+// RequestEntityTooLargeError is returned for a 413 Request Entity Too Large response. Some services,
+// such as Nova, also use it to signal that a rate limit has been exceeded.
+type RequestEntityTooLargeError struct {
+	UnexpectedResponseCodeError
+}
+
+// TooManyRequestsError is returned for a 429 Too Many Requests response.
+type TooManyRequestsError struct {
+	UnexpectedResponseCodeError
+}
+
+// InternalServerError is returned for a 500 Internal Server Error response.
+type InternalServerError struct {
+	UnexpectedResponseCodeError
+}
+
+// ServiceUnavailableError is returned for a 503 Service Unavailable response.
+type ServiceUnavailableError struct {
+	UnexpectedResponseCodeError
+}
+
+// requestIDHeaders lists the headers in which OpenStack services report the ID of a request.
+var requestIDHeaders = []string{"X-Openstack-Request-Id", "X-Compute-Request-Id", "X-Trans-Id"}
+
+// newResponseCodeError builds the most specific error available for an unexpected response.
+func newResponseCodeError(method, url string, expected []int, resp *http.Response, body []byte) error {
+	base := UnexpectedResponseCodeError{
+		URL:      url,
+		Method:   method,
+		Expected: expected,
+		Actual:   resp.StatusCode,
+		Body:     body,
+	}
+
+	for _, h := range requestIDHeaders {
+		if id := resp.Header.Get(h); id != "" {
+			base.RequestID = id
+			break
+		}
+	}
+
+	base.Fault, base.Message = parseFault(body)
+
+	switch resp.StatusCode {
+	case http.StatusBadRequest:
+		return &BadRequestError{base}
+	case http.StatusUnauthorized:
+		return &UnauthorizedError{base}
+	case http.StatusForbidden:
+		return &ForbiddenError{base}
+	case http.StatusNotFound:
+		return &NotFoundError{base}
+	case http.StatusConflict:
+		return &ConflictError{base}
+	case http.StatusRequestEntityTooLarge:
+		return &RequestEntityTooLargeError{base}
+	case http.StatusTooManyRequests:
+		return &TooManyRequestsError{base}
+	case http.StatusInternalServerError:
+		return &InternalServerError{base}
+	case http.StatusServiceUnavailable:
+		return &ServiceUnavailableError{base}
+	}
+	return &base
+}
+
+// parseFault extracts the fault name and message from an OpenStack error body. Most services wrap
+// the details in a single top-level object, e.g.
+//
+//   {"itemNotFound": {"message": "Instance could not be found", "code": 404}}
+//   {"NeutronError": {"message": "Network 1234 could not be found", "type": "NetworkNotFound"}}
+//
+// Bodies that aren't shaped like this, such as Swift's plain-text errors, yield empty strings.
+func parseFault(body []byte) (string, string) {
+	var parsed map[string]interface{}
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return "", ""
+	}
+
+	// Iterate in a stable order, since some services (e.g. Heat) return more than one key.
+	keys := make([]string, 0, len(parsed))
+	for k := range parsed {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		fault, ok := parsed[k].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if message, ok := fault["message"].(string); ok {
+			return k, message
+		}
+	}
+	return "", ""
+}
+
+// ResponseCodeIs returns true if err was caused by an HTTP response with the given status code.
+func ResponseCodeIs(err error, code int) bool {
+	casted, ok := err.(StatusCodeError)
+	return ok && casted.StatusCode() == code
+}
+
+// IsBadRequest returns true if err was caused by a 400 Bad Request response.
+func IsBadRequest(err error) bool {
+	return ResponseCodeIs(err, http.StatusBadRequest)
+}
+
+// IsUnauthorized returns true if err was caused by a 401 Unauthorized response.
+func IsUnauthorized(err error) bool {
+	return ResponseCodeIs(err, http.StatusUnauthorized)
+}
+
+// IsForbidden returns true if err was caused by a 403 Forbidden response.
+func IsForbidden(err error) bool {
+	return ResponseCodeIs(err, http.StatusForbidden)
+}
+
+// IsNotFound returns true if err was caused by a 404 Not Found response. This is useful to treat a
+// resource that has already been deleted as a success during cleanup:
+//
+//   if err := servers.Delete(client, id).ExtractErr(); err != nil && !gophercloud.IsNotFound(err) {
+//     return err
+//   }
+func IsNotFound(err error) bool {
+	return ResponseCodeIs(err, http.StatusNotFound)
+}
+
+// IsConflict returns true if err was caused by a 409 Conflict response.
+func IsConflict(err error) bool {
+	return ResponseCodeIs(err, http.StatusConflict)
+}
+
+// IsRateLimited returns true if err was caused by a 429 Too Many Requests response, or by a 413
+// response that carries a rate-limit fault ("overLimit") as returned by Nova.
+func IsRateLimited(err error) bool {
+	if ResponseCodeIs(err, http.StatusTooManyRequests) {
+		return true
+	}
+	casted, ok := err.(*RequestEntityTooLargeError)
+	return ok && casted.Fault == "overLimit"
+}
+
+// IsServerError returns true if err was caused by any 5xx response.
+func IsServerError(err error) bool {
+	casted, ok := err.(StatusCodeError)
+	return ok && casted.StatusCode() >= 500 && casted.StatusCode() < 600
+}
@@ -0,0 +1,107 @@
+package gophercloud
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/rackspace/gophercloud/testhelper"
+)
+
+func TestNotFoundError(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/servers/1234", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Compute-Request-Id", "req-5678")
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintf(w, `{"itemNotFound": {"message": "Instance could not be found", "code": 404}}`)
+	})
+
+	p := &ProviderClient{}
+	_, err := p.Request("GET", th.Endpoint()+"servers/1234", RequestOpts{})
+
+	casted, ok := err.(*NotFoundError)
+	if !ok {
+		t.Fatalf("Expected a NotFoundError, got %#v", err)
+	}
+	th.CheckEquals(t, "GET", casted.Method)
+	th.CheckEquals(t, th.Endpoint()+"servers/1234", casted.URL)
+	th.CheckEquals(t, 404, casted.Actual)
+	th.CheckEquals(t, "req-5678", casted.RequestID)
+	th.CheckEquals(t, "itemNotFound", casted.Fault)
+	th.CheckEquals(t, "Instance could not be found", casted.Message)
+
+	th.CheckEquals(t, true, IsNotFound(err))
+	th.CheckEquals(t, false, IsConflict(err))
+	th.CheckEquals(t, true, ResponseCodeIs(err, 404))
+}
+
+func TestResponseCodeErrorTypes(t *testing.T) {
+	expected := map[int]interface{}{
+		400: &BadRequestError{},
+		401: &UnauthorizedError{},
+		403: &ForbiddenError{},
+		404: &NotFoundError{},
+		409: &ConflictError{},
+		413: &RequestEntityTooLargeError{},
+		429: &TooManyRequestsError{},
+		500: &InternalServerError{},
+		503: &ServiceUnavailableError{},
+		418: &UnexpectedResponseCodeError{},
+	}
+
+	for code, want := range expected {
+		resp := &http.Response{StatusCode: code, Header: http.Header{}}
+		err := newResponseCodeError("GET", "http://example.com/", []int{200}, resp, nil)
+		if fmt.Sprintf("%T", err) != fmt.Sprintf("%T", want) {
+			t.Errorf("Status %d: expected %T, got %T", code, want, err)
+		}
+		th.CheckEquals(t, code, err.(StatusCodeError).StatusCode())
+	}
+}
+
+func TestParseFault(t *testing.T) {
+	fault, message := parseFault([]byte(`{"NeutronError": {"message": "Network 1234 could not be found", "type": "NetworkNotFound", "detail": ""}}`))
+	th.CheckEquals(t, "NeutronError", fault)
+	th.CheckEquals(t, "Network 1234 could not be found", message)
+
+	fault, message = parseFault([]byte(`{"error": {"message": "The request you have made requires authentication.", "code": 401, "title": "Unauthorized"}}`))
+	th.CheckEquals(t, "error", fault)
+	th.CheckEquals(t, "The request you have made requires authentication.", message)
+
+	fault, message = parseFault([]byte(`<html><h1>Not Found</h1></html>`))
+	th.CheckEquals(t, "", fault)
+	th.CheckEquals(t, "", message)
+}
+
+func TestIsRateLimited(t *testing.T) {
+	err := &RequestEntityTooLargeError{UnexpectedResponseCodeError{Actual: 413, Fault: "overLimit"}}
+	th.CheckEquals(t, true, IsRateLimited(err))
+
+	err = &RequestEntityTooLargeError{UnexpectedResponseCodeError{Actual: 413}}
+	th.CheckEquals(t, false, IsRateLimited(err))
+
+	th.CheckEquals(t, true, IsRateLimited(&TooManyRequestsError{UnexpectedResponseCodeError{Actual: 429}}))
+	th.CheckEquals(t, true, IsServerError(&ServiceUnavailableError{UnexpectedResponseCodeError{Actual: 503}}))
+	th.CheckEquals(t, false, IsServerError(fmt.Errorf("not an HTTP error")))
+}
+
+func TestAsUnexpectedResponseCodeError(t *testing.T) {
+	for _, code := range []int{404, 418} {
+		resp := &http.Response{StatusCode: code, Header: http.Header{}}
+		err := newResponseCodeError("GET", "http://example.com/", []int{200}, resp, []byte("body"))
+
+		casted, ok := AsUnexpectedResponseCodeError(err)
+		if !ok {
+			t.Fatalf("Status %d: expected an UnexpectedResponseCodeError, got %T", code, err)
+		}
+		th.CheckEquals(t, code, casted.Actual)
+		th.CheckEquals(t, "body", string(casted.Body))
+	}
+
+	if _, ok := AsUnexpectedResponseCodeError(fmt.Errorf("not an HTTP error")); ok {
+		t.Errorf("Expected a plain error not to be an UnexpectedResponseCodeError")
+	}
+}
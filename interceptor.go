@@ -0,0 +1,224 @@
+package gophercloud
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// RequestInfo describes the circumstances of a single HTTP request issued by ProviderClient.Request.
+type RequestInfo struct {
+	// ServiceType is the catalog type of the service the request is addressed to, if known.
+	ServiceType string
+
+	// OkCodes lists the status codes that the request will accept as success.
+	OkCodes []int
+
+	// Attempt counts the attempts made for this request by the client's RetryPolicy, starting at 1.
+	Attempt int
+
+	// Reauthenticated is true if the request is being repeated after a successful reauthentication.
+	Reauthenticated bool
+}
+
+// Interceptor observes the HTTP traffic of a ProviderClient. Interceptors are invoked in order
+// before each request is sent, and in reverse order once its response (or error) is available.
+//
+// An Interceptor may add headers to the request or inspect the response, but if it reads either
+// body it must replace it with an equivalent unread one.
+type Interceptor interface {
+	// BeforeRequest is called just before req is sent.
+	BeforeRequest(req *http.Request, info RequestInfo)
+
+	// AfterResponse is called once req has completed. Exactly one of resp and err is non-nil.
+	AfterResponse(req *http.Request, resp *http.Response, err error, info RequestInfo)
+}
+
+// Logger is satisfied by *log.Logger, and by most structured logging libraries.
+type Logger interface {
+	Printf(format string, v ...interface{})
+}
+
+// redacted replaces sensitive values in logged requests and responses.
+const redacted = "***"
+
+// sensitiveHeaders lists the headers whose values DebugLogger never logs.
+var sensitiveHeaders = map[string]bool{
+	"Authorization":                      true,
+	"Cookie":                             true,
+	"Set-Cookie":                         true,
+	"X-Auth-Token":                       true,
+	"X-Subject-Token":                    true,
+	"X-Auth-Key":                         true,
+	"X-Storage-Token":                    true,
+	"X-Account-Meta-Temp-Url-Key":        true,
+	"X-Account-Meta-Temp-Url-Key-2":      true,
+	"X-Container-Meta-Temp-Url-Key":      true,
+	"X-Container-Meta-Temp-Url-Key-2":    true,
+	"X-Remove-Account-Meta-Temp-Url-Key": true,
+}
+
+// sensitiveFields lists the JSON keys whose values DebugLogger never logs, wherever they appear.
+var sensitiveFields = map[string]bool{
+	"password":          true,
+	"original_password": true,
+	"apiKey":            true,
+	"secret":            true,
+	"passcode":          true,
+	"adminPass":         true,
+}
+
+// sensitiveQueryParams lists the query parameters whose values DebugLogger never logs.
+var sensitiveQueryParams = []string{"temp_url_sig"}
+
+/*
+DebugLogger is an Interceptor that logs the method, URL, headers and JSON
+bodies of every request and response. Tokens, passwords, API keys, cookies and
+Swift temporary URL keys and signatures are redacted, so the output is safe to
+collect while troubleshooting a production system.
+
+  provider.Interceptors = append(provider.Interceptors, &gophercloud.DebugLogger{})
+
+Non-JSON bodies, such as object storage uploads and downloads, are never read.
+*/
+type DebugLogger struct {
+	// Logger receives the output. When nil, the standard library's default logger is used.
+	Logger Logger
+}
+
+func (l *DebugLogger) printf(format string, v ...interface{}) {
+	if l.Logger == nil {
+		log.Printf(format, v...)
+		return
+	}
+	l.Logger.Printf(format, v...)
+}
+
+// BeforeRequest logs an outgoing request.
+func (l *DebugLogger) BeforeRequest(req *http.Request, info RequestInfo) {
+	var body string
+	if req.Body != nil && isJSON(req.Header) {
+		b, err := ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		req.Body = ioutil.NopCloser(bytes.NewReader(b))
+		// Redirects and retries re-read the body through GetBody, which still refers to the reader
+		// that was just drained.
+		req.GetBody = func() (io.ReadCloser, error) {
+			return ioutil.NopCloser(bytes.NewReader(b)), nil
+		}
+		if err == nil {
+			body = redactBody(b)
+		}
+	}
+
+	l.printf("[gophercloud] request: %s %s (service=%q attempt=%d reauthenticated=%t)\n%s%s",
+		req.Method, redactURL(req.URL), info.ServiceType, info.Attempt, info.Reauthenticated,
+		formatHeaders(req.Header), body)
+}
+
+// AfterResponse logs the response to a request, or the error that prevented one.
+func (l *DebugLogger) AfterResponse(req *http.Request, resp *http.Response, err error, info RequestInfo) {
+	if err != nil {
+		l.printf("[gophercloud] error: %s %s: %s", req.Method, redactURL(req.URL), err)
+		return
+	}
+
+	var body string
+	if isJSON(resp.Header) {
+		b, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		resp.Body = ioutil.NopCloser(bytes.NewReader(b))
+		if err == nil {
+			body = redactBody(b)
+		}
+	}
+
+	l.printf("[gophercloud] response: %s %s: %s (expected %v)\n%s%s",
+		req.Method, redactURL(req.URL), resp.Status, info.OkCodes, formatHeaders(resp.Header), body)
+}
+
+func isJSON(h http.Header) bool {
+	return strings.HasPrefix(h.Get("Content-Type"), "application/json")
+}
+
+// formatHeaders renders headers one per line, in a stable order, with sensitive values redacted.
+func formatHeaders(h http.Header) string {
+	keys := make([]string, 0, len(h))
+	for k := range h {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var b bytes.Buffer
+	for _, k := range keys {
+		v := strings.Join(h[k], ", ")
+		if sensitiveHeaders[http.CanonicalHeaderKey(k)] {
+			v = redacted
+		}
+		b.WriteString(k + ": " + v + "\n")
+	}
+	return b.String()
+}
+
+// redactURL hides the signature of Swift temporary URLs.
+func redactURL(u *url.URL) string {
+	q := u.Query()
+	changed := false
+	for _, p := range sensitiveQueryParams {
+		if q.Get(p) != "" {
+			q.Set(p, redacted)
+			changed = true
+		}
+	}
+	if !changed {
+		return u.String()
+	}
+
+	c := *u
+	c.RawQuery = q.Encode()
+	return c.String()
+}
+
+// redactBody hides credentials in a JSON body. This covers passwords and API keys in identity
+// requests, as well as the token IDs returned by identity v2.
+func redactBody(b []byte) string {
+	// A body that can't be parsed can't be redacted either, so it's never logged.
+	unparseable := fmt.Sprintf("<%d bytes of unparseable JSON omitted>", len(b))
+
+	var parsed interface{}
+	if err := json.Unmarshal(b, &parsed); err != nil {
+		return unparseable
+	}
+
+	redactValue(parsed, "")
+
+	rendered, err := json.Marshal(parsed)
+	if err != nil {
+		return unparseable
+	}
+	return string(rendered)
+}
+
+func redactValue(v interface{}, parent string) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, child := range v {
+			if sensitiveFields[k] || (parent == "token" && k == "id") {
+				v[k] = redacted
+				continue
+			}
+			redactValue(child, k)
+		}
+	case []interface{}:
+		for _, child := range v {
+			redactValue(child, parent)
+		}
+	}
+}
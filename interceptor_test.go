@@ -0,0 +1,174 @@
+package gophercloud
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strings"
+	"testing"
+
+	th "github.com/rackspace/gophercloud/testhelper"
+)
+
+type recordingInterceptor struct {
+	name  string
+	calls *[]string
+	infos []RequestInfo
+}
+
+func (i *recordingInterceptor) BeforeRequest(req *http.Request, info RequestInfo) {
+	*i.calls = append(*i.calls, "before "+i.name)
+	i.infos = append(i.infos, info)
+}
+
+func (i *recordingInterceptor) AfterResponse(req *http.Request, resp *http.Response, err error, info RequestInfo) {
+	*i.calls = append(*i.calls, "after "+i.name)
+}
+
+func TestInterceptorOrder(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	var calls []string
+	first := &recordingInterceptor{name: "first", calls: &calls}
+	second := &recordingInterceptor{name: "second", calls: &calls}
+
+	c := &ServiceClient{
+		ProviderClient: &ProviderClient{Interceptors: []Interceptor{first, second}},
+		Endpoint:       th.Endpoint(),
+		Type:           "compute",
+	}
+	_, err := c.Get(c.ServiceURL("servers"), nil, nil)
+	th.AssertNoErr(t, err)
+
+	th.CheckDeepEquals(t, []string{"before first", "before second", "after second", "after first"}, calls)
+	th.CheckDeepEquals(t, []RequestInfo{{ServiceType: "compute", OkCodes: []int{200}, Attempt: 1}}, first.infos)
+}
+
+func TestInterceptorSeesReauthentication(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Auth-Token") != "fresh" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	var calls []string
+	rec := &recordingInterceptor{name: "rec", calls: &calls}
+
+	p := &ProviderClient{TokenID: "stale", Interceptors: []Interceptor{rec}}
+	p.ReauthFunc = func() error {
+		p.TokenID = "fresh"
+		return nil
+	}
+	_, err := p.Request("GET", th.Endpoint(), RequestOpts{})
+	th.AssertNoErr(t, err)
+
+	th.CheckEquals(t, 2, len(rec.infos))
+	th.CheckEquals(t, false, rec.infos[0].Reauthenticated)
+	th.CheckEquals(t, true, rec.infos[1].Reauthenticated)
+}
+
+func TestDebugLoggerRedacts(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/tokens", func(w http.ResponseWriter, r *http.Request) {
+		th.TestJSONRequest(t, r, `{"auth": {"passwordCredentials": {"username": "me", "password": "swordfish"}}}`)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `{"access": {"token": {"id": "secret-token-id", "expires": "2014-01-31T15:30:58Z"}}}`)
+	})
+
+	var buf bytes.Buffer
+	p := &ProviderClient{
+		TokenID:      "secret-token-id",
+		Interceptors: []Interceptor{&DebugLogger{Logger: log.New(&buf, "", 0)}},
+	}
+
+	var body interface{}
+	_, err := p.Request("POST", th.Endpoint()+"tokens?temp_url_sig=abcdef", RequestOpts{
+		JSONBody:     map[string]interface{}{"auth": map[string]interface{}{"passwordCredentials": map[string]interface{}{"username": "me", "password": "swordfish"}}},
+		JSONResponse: &body,
+		OkCodes:      []int{200},
+		MoreHeaders:  map[string]string{"X-Account-Meta-Temp-URL-Key": "tempkey"},
+	})
+	th.AssertNoErr(t, err)
+
+	// The response body must still be available to the caller.
+	th.CheckJSONEquals(t, `{"access": {"token": {"id": "secret-token-id", "expires": "2014-01-31T15:30:58Z"}}}`, body)
+
+	out := buf.String()
+	for _, secret := range []string{"swordfish", "secret-token-id", "tempkey", "abcdef"} {
+		if strings.Contains(out, secret) {
+			t.Errorf("Expected %q to be redacted from the log, but got:\n%s", secret, out)
+		}
+	}
+	for _, expected := range []string{"POST", `"username":"me"`, "200 OK"} {
+		if !strings.Contains(out, expected) {
+			t.Errorf("Expected %q in the log, but got:\n%s", expected, out)
+		}
+	}
+}
+
+func TestDebugLoggerSkipsRawBodies(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		th.CheckEquals(t, "object contents", string(b))
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	var buf bytes.Buffer
+	p := &ProviderClient{Interceptors: []Interceptor{&DebugLogger{Logger: log.New(&buf, "", 0)}}}
+	_, err := p.Request("PUT", th.Endpoint(), RequestOpts{RawBody: strings.NewReader("object contents")})
+	th.AssertNoErr(t, err)
+
+	if strings.Contains(buf.String(), "object contents") {
+		t.Errorf("Expected the raw body not to be logged, but got:\n%s", buf.String())
+	}
+}
+
+func TestDebugLoggerResetsGetBody(t *testing.T) {
+	req, err := http.NewRequest("POST", "http://example.com/", strings.NewReader(`{"name": "foo"}`))
+	th.AssertNoErr(t, err)
+	req.Header.Set("Content-Type", "application/json")
+
+	var buf bytes.Buffer
+	(&DebugLogger{Logger: log.New(&buf, "", 0)}).BeforeRequest(req, RequestInfo{})
+
+	body, err := req.GetBody()
+	th.AssertNoErr(t, err)
+	b, err := ioutil.ReadAll(body)
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, `{"name": "foo"}`, string(b))
+}
+
+func TestDebugLoggerOmitsUnparseableBodies(t *testing.T) {
+	req, err := http.NewRequest("POST", "http://example.com/", strings.NewReader(`{"password": "swordfish"`))
+	th.AssertNoErr(t, err)
+	req.Header.Set("Content-Type", "application/json")
+
+	var buf bytes.Buffer
+	(&DebugLogger{Logger: log.New(&buf, "", 0)}).BeforeRequest(req, RequestInfo{})
+
+	out := buf.String()
+	if strings.Contains(out, "swordfish") {
+		t.Errorf("Expected the unparseable body not to be logged, but got:\n%s", out)
+	}
+	if !strings.Contains(out, "unparseable JSON omitted") {
+		t.Errorf("Expected a placeholder for the unparseable body, but got:\n%s", out)
+	}
+}
@@ -15,13 +15,20 @@ var (
 	ErrNoAuthURL  = fmt.Errorf("Environment variable OS_AUTH_URL needs to be set.")
 	ErrNoUsername = fmt.Errorf("Environment variable OS_USERNAME, OS_USERID, or OS_TOKEN needs to be set.")
 	ErrNoPassword = fmt.Errorf("Environment variable OS_PASSWORD or OS_TOKEN needs to be set.")
+
+	// ErrNoApplicationCredentialSecret indicates that an application credential was named without
+	// OS_APPLICATION_CREDENTIAL_SECRET.
+	ErrNoApplicationCredentialSecret = fmt.Errorf("Environment variable OS_APPLICATION_CREDENTIAL_SECRET needs to be set.")
 )
 
 // AuthOptionsFromEnv fills out an AuthOptions structure from the environment
 // variables: OS_AUTH_URL, OS_USERNAME, OS_USERID, OS_PASSWORD, OS_TENANT_ID,
-// OS_TENANT_NAME, OS_DOMAIN_ID, OS_DOMAIN_NAME, OS_TOKEN.  It checks that
-// (1) OS_AUTH_URL is set, (2) OS_USERNAME, OS_USERID, or OS_TOKEN is set,
-// (3) OS_PASSWORD or OS_TOKEN is set.
+// OS_TENANT_NAME, OS_DOMAIN_ID, OS_DOMAIN_NAME, OS_TOKEN, OS_PASSCODE,
+// OS_APPLICATION_CREDENTIAL_ID, OS_APPLICATION_CREDENTIAL_NAME and
+// OS_APPLICATION_CREDENTIAL_SECRET.  It checks that (1) OS_AUTH_URL is set,
+// (2) OS_USERNAME, OS_USERID, or OS_TOKEN is set, (3) OS_PASSWORD, OS_PASSCODE
+// or OS_TOKEN is set. An application credential's ID or secret takes the place
+// of (2) and (3).
 func AuthOptionsFromEnv() (gophercloud.AuthOptions, error) {
 	authURL := os.Getenv("OS_AUTH_URL")
 	username := os.Getenv("OS_USERNAME")
@@ -32,17 +39,30 @@ func AuthOptionsFromEnv() (gophercloud.AuthOptions, error) {
 	domainID := os.Getenv("OS_DOMAIN_ID")
 	domainName := os.Getenv("OS_DOMAIN_NAME")
 	tokenID := os.Getenv("OS_TOKEN")
+	passcode := os.Getenv("OS_PASSCODE")
+	appCredID := os.Getenv("OS_APPLICATION_CREDENTIAL_ID")
+	appCredName := os.Getenv("OS_APPLICATION_CREDENTIAL_NAME")
+	appCredSecret := os.Getenv("OS_APPLICATION_CREDENTIAL_SECRET")
 
 	if authURL == "" {
 		return nilOptions, ErrNoAuthURL
 	}
 
-	if username == "" && userID == "" && tokenID == "" {
-		return nilOptions, ErrNoUsername
-	}
+	if appCredID != "" || appCredName != "" {
+		if appCredSecret == "" {
+			return nilOptions, ErrNoApplicationCredentialSecret
+		}
+		if appCredID == "" && username == "" && userID == "" {
+			return nilOptions, ErrNoUsername
+		}
+	} else {
+		if username == "" && userID == "" && tokenID == "" {
+			return nilOptions, ErrNoUsername
+		}
 
-	if password == "" && tokenID == "" {
-		return nilOptions, ErrNoPassword
+		if password == "" && passcode == "" && tokenID == "" {
+			return nilOptions, ErrNoPassword
+		}
 	}
 
 	ao := gophercloud.AuthOptions{
@@ -55,6 +75,11 @@ func AuthOptionsFromEnv() (gophercloud.AuthOptions, error) {
 		DomainID:         domainID,
 		DomainName:       domainName,
 		TokenID:          tokenID,
+		Passcode:         passcode,
+
+		ApplicationCredentialID:     appCredID,
+		ApplicationCredentialName:   appCredName,
+		ApplicationCredentialSecret: appCredSecret,
 	}
 
 	return ao, nil
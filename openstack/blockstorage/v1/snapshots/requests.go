@@ -204,3 +204,12 @@ func IDFromName(client *gophercloud.ServiceClient, name string) (string, error)
 		return "", fmt.Errorf("Found %d snapshots matching %s", snapshotCount, name)
 	}
 }
+
+// ListAll returns the snapshots that match opts.
+func ListAll(client *gophercloud.ServiceClient, opts ListOptsBuilder) ([]Snapshot, error) {
+	pages, err := List(client, opts).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	return ExtractSnapshots(pages)
+}
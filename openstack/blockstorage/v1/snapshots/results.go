@@ -69,6 +69,11 @@ type ListResult struct {
 	pagination.SinglePageBase
 }
 
+// CollectionKey returns "snapshots".
+func (page ListResult) CollectionKey() string {
+	return "snapshots"
+}
+
 // IsEmpty returns true if a ListResult contains no Snapshots.
 func (r ListResult) IsEmpty() (bool, error) {
 	volumes, err := ExtractSnapshots(r)
@@ -234,3 +234,12 @@ func IDFromName(client *gophercloud.ServiceClient, name string) (string, error)
 		return "", fmt.Errorf("Found %d volumes matching %s", volumeCount, name)
 	}
 }
+
+// ListAll returns the volumes that match opts.
+func ListAll(client *gophercloud.ServiceClient, opts ListOptsBuilder) ([]Volume, error) {
+	pages, err := List(client, opts).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	return ExtractVolumes(pages)
+}
@@ -69,6 +69,11 @@ type ListResult struct {
 	pagination.SinglePageBase
 }
 
+// CollectionKey returns "volumes".
+func (page ListResult) CollectionKey() string {
+	return "volumes"
+}
+
 // IsEmpty returns true if a ListResult contains no Volumes.
 func (r ListResult) IsEmpty() (bool, error) {
 	volumes, err := ExtractVolumes(r)
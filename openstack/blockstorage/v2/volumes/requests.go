@@ -40,6 +40,9 @@ type CreateOpts struct {
 	ImageID string
 	// The associated volume type [OPTIONAL]
 	VolumeType string
+	// Whether the volume can be attached to more than one server at once [OPTIONAL].
+	// Attaching it to a second server requires Compute microversion 2.60 or later.
+	Multiattach bool
 }
 
 // ToVolumeCreateMap assembles a request body based on the contents of a
@@ -82,6 +85,9 @@ func (opts CreateOpts) ToVolumeCreateMap() (map[string]interface{}, error) {
 	if opts.VolumeType != "" {
 		v["volume_type"] = opts.VolumeType
 	}
+	if opts.Multiattach {
+		v["multiattach"] = true
+	}
 
 	return map[string]interface{}{"volume": v}, nil
 }
@@ -249,3 +255,12 @@ func IDFromName(client *gophercloud.ServiceClient, name string) (string, error)
 		return "", fmt.Errorf("Found %d volumes matching %s", volumeCount, name)
 	}
 }
+
+// ListAll returns the volumes that match opts.
+func ListAll(client *gophercloud.ServiceClient, opts ListOptsBuilder) ([]Volume, error) {
+	pages, err := List(client, opts).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	return ExtractVolumes(pages)
+}
@@ -93,6 +93,11 @@ type ListResult struct {
 	pagination.SinglePageBase
 }
 
+// CollectionKey returns "volumes", leaving out "volumes_links".
+func (page ListResult) CollectionKey() string {
+	return "volumes"
+}
+
 // IsEmpty returns true if a ListResult contains no Volumes.
 func (r ListResult) IsEmpty() (bool, error) {
 	volumes, err := ExtractVolumes(r)
@@ -1,22 +1,29 @@
 package volumes
 
-import (
-	"github.com/rackspace/gophercloud"
-)
+import "github.com/rackspace/gophercloud"
 
-// WaitForStatus will continually poll the resource, checking for a particular
-// status. It will do this for the amount of seconds defined.
-func WaitForStatus(c *gophercloud.ServiceClient, id, status string, secs int) error {
-	return gophercloud.WaitFor(secs, func() (bool, error) {
+// StatusFunc returns a gophercloud.StatusFunc that reports the status of a volume, for use with a
+// gophercloud.Waiter.
+func StatusFunc(c *gophercloud.ServiceClient, id string) gophercloud.StatusFunc {
+	return func() (string, error) {
 		current, err := Get(c, id).Extract()
 		if err != nil {
-			return false, err
+			return "", err
 		}
+		return current.Status, nil
+	}
+}
 
-		if current.Status == status {
-			return true, nil
-		}
+// failureStatuses are the statuses from which a volume won't reach any other status on its own.
+var failureStatuses = []string{"error", "error_deleting", "error_restoring", "error_extending"}
 
-		return false, nil
-	})
+// WaitForStatus will continually poll the resource, checking for a particular
+// status. It will do this for the amount of seconds defined, and gives up as soon
+// as the volume goes into an error state.
+func WaitForStatus(c *gophercloud.ServiceClient, id, status string, secs int) error {
+	w := gophercloud.Waiter{
+		Target:  []string{status},
+		Failure: failureStatuses,
+	}
+	return w.WaitSeconds(c.Context, secs, StatusFunc(c, id))
 }
@@ -86,7 +86,12 @@ func AuthenticatedClient(options gophercloud.AuthOptions) (*gophercloud.Provider
 }
 
 // Authenticate or re-authenticate against the most recent identity service supported at the provided endpoint.
+// If options.TokenStore holds a valid token for the same user and scope, it's used instead.
 func Authenticate(client *gophercloud.ProviderClient, options gophercloud.AuthOptions) error {
+	if LoadToken(client, options, "", nil) {
+		return nil
+	}
+
 	versions := []*utils.Version{
 		{ID: v20, Priority: 20, Suffix: "/v2.0/"},
 		{ID: v30, Priority: 30, Suffix: "/v3/"},
@@ -110,6 +115,9 @@ func Authenticate(client *gophercloud.ProviderClient, options gophercloud.AuthOp
 
 // AuthenticateV2 explicitly authenticates against the identity v2 endpoint.
 func AuthenticateV2(client *gophercloud.ProviderClient, options gophercloud.AuthOptions) error {
+	if LoadToken(client, options, v20, nil) {
+		return nil
+	}
 	return v2auth(client, "", options)
 }
 
@@ -131,22 +139,26 @@ func v2auth(client *gophercloud.ProviderClient, endpoint string, options gopherc
 		return err
 	}
 
+	StoreToken(client, options, v20, v2Client.Endpoint, token.ID, token.ExpiresAt, catalog)
+
 	if options.AllowReauth {
-		client.ReauthFunc = func() error {
-			client.TokenID = ""
-			return v2auth(client, endpoint, options)
-		}
+		client.ReauthFunc = reauthFunc(client, endpoint, options, v2auth)
 	}
-	client.TokenID = token.ID
+	client.SetToken(token.ID)
+	client.SetTokenExpiresAt(token.ExpiresAt)
 	client.EndpointLocator = func(opts gophercloud.EndpointOpts) (string, error) {
 		return V2EndpointURL(catalog, opts)
 	}
+	client.ServiceCatalog = V2ServiceCatalog(catalog)
 
 	return nil
 }
 
 // AuthenticateV3 explicitly authenticates against the identity v3 service.
 func AuthenticateV3(client *gophercloud.ProviderClient, options gophercloud.AuthOptions) error {
+	if LoadToken(client, options, v30, nil) {
+		return nil
+	}
 	return v3auth(client, "", options)
 }
 
@@ -191,17 +203,18 @@ func v3auth(client *gophercloud.ProviderClient, endpoint string, options gopherc
 		return err
 	}
 
-	client.TokenID = token.ID
+	StoreToken(client, options, v30, v3Client.Endpoint, token.ID, token.ExpiresAt, catalog)
+
+	client.SetToken(token.ID)
+	client.SetTokenExpiresAt(token.ExpiresAt)
 
 	if options.AllowReauth {
-		client.ReauthFunc = func() error {
-			client.TokenID = ""
-			return v3auth(client, endpoint, options)
-		}
+		client.ReauthFunc = reauthFunc(client, endpoint, options, v3auth)
 	}
 	client.EndpointLocator = func(opts gophercloud.EndpointOpts) (string, error) {
 		return V3EndpointURL(catalog, opts)
 	}
+	client.ServiceCatalog = V3ServiceCatalog(catalog)
 
 	return nil
 }
@@ -213,6 +226,7 @@ func NewIdentityV2(client *gophercloud.ProviderClient) *gophercloud.ServiceClien
 	return &gophercloud.ServiceClient{
 		ProviderClient: client,
 		Endpoint:       v2Endpoint,
+		Type:           "identity",
 	}
 }
 
@@ -223,6 +237,7 @@ func NewIdentityV3(client *gophercloud.ProviderClient) *gophercloud.ServiceClien
 	return &gophercloud.ServiceClient{
 		ProviderClient: client,
 		Endpoint:       v3Endpoint,
+		Type:           "identity",
 	}
 }
 
@@ -240,7 +255,7 @@ func NewIdentityAdminV2(client *gophercloud.ProviderClient, eo gophercloud.Endpo
 		url = strings.Replace(url, "/v3", "/v2.0", -1)
 	}
 
-	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url}, nil
+	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url, Type: eo.Type}, nil
 }
 
 func NewIdentityAdminV3(client *gophercloud.ProviderClient, eo gophercloud.EndpointOpts) (*gophercloud.ServiceClient, error) {
@@ -257,7 +272,7 @@ func NewIdentityAdminV3(client *gophercloud.ProviderClient, eo gophercloud.Endpo
 		url = strings.Replace(url, "/v2.0", "/v3", -1)
 	}
 
-	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url}, nil
+	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url, Type: eo.Type}, nil
 }
 
 // NewObjectStorageV1 creates a ServiceClient that may be used with the v1 object storage package.
@@ -267,7 +282,7 @@ func NewObjectStorageV1(client *gophercloud.ProviderClient, eo gophercloud.Endpo
 	if err != nil {
 		return nil, err
 	}
-	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url}, nil
+	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url, Type: eo.Type}, nil
 }
 
 // NewComputeV2 creates a ServiceClient that may be used with the v2 compute package.
@@ -277,7 +292,7 @@ func NewComputeV2(client *gophercloud.ProviderClient, eo gophercloud.EndpointOpt
 	if err != nil {
 		return nil, err
 	}
-	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url}, nil
+	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url, Type: eo.Type}, nil
 }
 
 // NewNetworkV2 creates a ServiceClient that may be used with the v2 network package.
@@ -290,6 +305,7 @@ func NewNetworkV2(client *gophercloud.ProviderClient, eo gophercloud.EndpointOpt
 	return &gophercloud.ServiceClient{
 		ProviderClient: client,
 		Endpoint:       url,
+		Type:           eo.Type,
 		ResourceBase:   url + "v2.0/",
 	}, nil
 }
@@ -301,7 +317,7 @@ func NewBlockStorageV1(client *gophercloud.ProviderClient, eo gophercloud.Endpoi
 	if err != nil {
 		return nil, err
 	}
-	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url}, nil
+	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url, Type: eo.Type}, nil
 }
 
 // NewBlockStorageV2 creates a ServiceClient that may be used to access the v2 block storage service.
@@ -311,7 +327,7 @@ func NewBlockStorageV2(client *gophercloud.ProviderClient, eo gophercloud.Endpoi
 	if err != nil {
 		return nil, err
 	}
-	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url}, nil
+	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url, Type: eo.Type}, nil
 }
 
 // NewCDNV1 creates a ServiceClient that may be used to access the OpenStack v1
@@ -322,7 +338,7 @@ func NewCDNV1(client *gophercloud.ProviderClient, eo gophercloud.EndpointOpts) (
 	if err != nil {
 		return nil, err
 	}
-	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url}, nil
+	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url, Type: eo.Type}, nil
 }
 
 // NewOrchestrationV1 creates a ServiceClient that may be used to access the v1 orchestration service.
@@ -332,7 +348,7 @@ func NewOrchestrationV1(client *gophercloud.ProviderClient, eo gophercloud.Endpo
 	if err != nil {
 		return nil, err
 	}
-	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url}, nil
+	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url, Type: eo.Type}, nil
 }
 
 // NewDBV1 creates a ServiceClient that may be used to access the v1 DB service.
@@ -342,7 +358,7 @@ func NewDBV1(client *gophercloud.ProviderClient, eo gophercloud.EndpointOpts) (*
 	if err != nil {
 		return nil, err
 	}
-	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url}, nil
+	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url, Type: eo.Type}, nil
 }
 
 // NewImageServiceV2 creates a ServiceClient that may be used to access the v2 image service.
@@ -354,6 +370,7 @@ func NewImageServiceV2(client *gophercloud.ProviderClient, eo gophercloud.Endpoi
 	}
 	return &gophercloud.ServiceClient{ProviderClient: client,
 		Endpoint:     url,
+		Type:         eo.Type,
 		ResourceBase: url + "v2/"}, nil
 }
 
@@ -364,5 +381,5 @@ func NewTelemetryV2(client *gophercloud.ProviderClient, eo gophercloud.EndpointO
 	if err != nil {
 		return nil, err
 	}
-	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url}, nil
+	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url, Type: eo.Type}, nil
 }
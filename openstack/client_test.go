@@ -3,7 +3,9 @@ package openstack
 import (
 	"fmt"
 	"net/http"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/rackspace/gophercloud"
 	th "github.com/rackspace/gophercloud/testhelper"
@@ -158,6 +160,10 @@ func TestAuthenticatedClientV2(t *testing.T) {
 	client, err := AuthenticatedClient(options)
 	th.AssertNoErr(t, err)
 	th.CheckEquals(t, "01234567890", client.TokenID)
+	th.CheckDeepEquals(t, []string{"compute", "object-store"}, client.ServiceCatalog.Types())
+	th.CheckDeepEquals(t, []string{"North", "South"}, client.ServiceCatalog.Regions())
+	th.CheckEquals(t, true, client.ServiceCatalog.Has(gophercloud.EndpointOpts{Type: "object-store", Region: "South"}))
+	th.CheckEquals(t, false, client.ServiceCatalog.Has(gophercloud.EndpointOpts{Type: "compute", Region: "South"}))
 }
 
 func TestNewClient(t *testing.T) {
@@ -251,3 +257,117 @@ func TestNewClient(t *testing.T) {
 	th.AssertEquals(t, "https://v3.v2.0example.com/", client.IdentityBase)
 
 }
+
+func TestConcurrentReauthentication(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	var mu sync.Mutex
+	issued := 0
+	valid := ""
+
+	th.Mux.HandleFunc("/v2.0/tokens", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+
+		mu.Lock()
+		issued++
+		valid = fmt.Sprintf("token-%d", issued)
+		token := valid
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"access": {"token": {"id": "%s", "expires": "2999-10-01T10:00:00.000000Z"}, "serviceCatalog": []}}`, token)
+	})
+
+	th.Mux.HandleFunc("/resource", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		ok := r.Header.Get("X-Auth-Token") == valid
+		mu.Unlock()
+
+		if !ok {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	client, err := NewClient(th.Endpoint())
+	th.AssertNoErr(t, err)
+	err = AuthenticateV2(client, gophercloud.AuthOptions{
+		Username:    "me",
+		Password:    "secret",
+		AllowReauth: true,
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "token-1", client.Token())
+
+	// Expire the token on the server side.
+	mu.Lock()
+	valid = "revoked"
+	mu.Unlock()
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 50)
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := client.Request("GET", th.Endpoint()+"resource", gophercloud.RequestOpts{})
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		th.CheckNoErr(t, err)
+	}
+	th.CheckEquals(t, 2, issued)
+	th.CheckEquals(t, "token-2", client.Token())
+}
+
+func TestProactiveTokenRenewal(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	issued := 0
+	th.Mux.HandleFunc("/v2.0/tokens", func(w http.ResponseWriter, r *http.Request) {
+		issued++
+
+		// The first token is about to expire; its replacement lasts for an hour.
+		lifetime := 30 * time.Second
+		if issued > 1 {
+			lifetime = time.Hour
+		}
+		expires := time.Now().UTC().Add(lifetime).Format(gophercloud.RFC3339Milli)
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"access": {"token": {"id": "token-%d", "expires": "%s"}, "serviceCatalog": []}}`, issued, expires)
+	})
+
+	th.Mux.HandleFunc("/resource", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", "token-2")
+		w.WriteHeader(http.StatusOK)
+	})
+
+	client, err := NewClient(th.Endpoint())
+	th.AssertNoErr(t, err)
+	err = AuthenticateV2(client, gophercloud.AuthOptions{
+		Username:    "me",
+		Password:    "secret",
+		AllowReauth: true,
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "token-1", client.Token())
+
+	for i := 0; i < 3; i++ {
+		_, err = client.Request("GET", th.Endpoint()+"resource", gophercloud.RequestOpts{})
+		th.AssertNoErr(t, err)
+	}
+
+	th.CheckEquals(t, 2, issued)
+	th.CheckEquals(t, "token-2", client.Token())
+	if d := client.TokenExpiresAt().Sub(time.Now()); d < 59*time.Minute {
+		t.Errorf("Expected the renewed token to expire in an hour, but it expires in %s", d)
+	}
+}
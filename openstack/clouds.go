@@ -0,0 +1,384 @@
+package openstack
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/rackspace/gophercloud"
+	"gopkg.in/yaml.v2"
+)
+
+// Cloud is the configuration of a single cloud, as read from a clouds.yaml file by CloudFromYAML.
+type Cloud struct {
+	// Name is the cloud's key in clouds.yaml.
+	Name string
+
+	// AuthOptions holds the cloud's identity endpoint and credentials.
+	AuthOptions gophercloud.AuthOptions
+
+	// IdentityAPIVersion is "2", "2.0" or "3" to force a version of the identity service, or empty
+	// to use the most recent one available.
+	IdentityAPIVersion string
+
+	// RegionName is the region in which service clients should be created.
+	RegionName string
+
+	// Interface is the availability of the endpoints that service clients should use. Defaults to
+	// AvailabilityPublic.
+	Interface gophercloud.Availability
+
+	// CACertFile is the path to a PEM bundle of certificate authorities to trust, in addition to
+	// the system's.
+	CACertFile string
+
+	// ClientCertFile and ClientKeyFile are the paths to a PEM certificate and key with which to
+	// authenticate TLS connections.
+	ClientCertFile, ClientKeyFile string
+
+	// Insecure disables the verification of the cloud's TLS certificates ("verify: false").
+	Insecure bool
+}
+
+// cloudEntry mirrors the configuration of a cloud in clouds.yaml and secure.yaml.
+type cloudEntry struct {
+	Auth struct {
+		AuthURL           string `yaml:"auth_url"`
+		Username          string `yaml:"username"`
+		UserID            string `yaml:"user_id"`
+		Password          string `yaml:"password"`
+		APIKey            string `yaml:"api_key"`
+		ProjectID         string `yaml:"project_id"`
+		ProjectName       string `yaml:"project_name"`
+		TenantID          string `yaml:"tenant_id"`
+		TenantName        string `yaml:"tenant_name"`
+		DomainID          string `yaml:"domain_id"`
+		DomainName        string `yaml:"domain_name"`
+		UserDomainID      string `yaml:"user_domain_id"`
+		UserDomainName    string `yaml:"user_domain_name"`
+		ProjectDomainID   string `yaml:"project_domain_id"`
+		ProjectDomainName string `yaml:"project_domain_name"`
+		Token             string `yaml:"token"`
+		Passcode          string `yaml:"passcode"`
+
+		ApplicationCredentialID     string `yaml:"application_credential_id"`
+		ApplicationCredentialName   string `yaml:"application_credential_name"`
+		ApplicationCredentialSecret string `yaml:"application_credential_secret"`
+	} `yaml:"auth"`
+	IdentityAPIVersion string `yaml:"identity_api_version"`
+	RegionName         string `yaml:"region_name"`
+	Interface          string `yaml:"interface"`
+	EndpointType       string `yaml:"endpoint_type"`
+	CACert             string `yaml:"cacert"`
+	Cert               string `yaml:"cert"`
+	Key                string `yaml:"key"`
+	Verify             *bool  `yaml:"verify"`
+}
+
+// cloudsSearchPath lists the directories searched for clouds.yaml and secure.yaml, in order of
+// precedence: the current directory, the user's configuration directory, then /etc/openstack.
+func cloudsSearchPath() []string {
+	dirs := []string{"."}
+
+	config := os.Getenv("XDG_CONFIG_HOME")
+	if config == "" {
+		if home := os.Getenv("HOME"); home != "" {
+			config = filepath.Join(home, ".config")
+		}
+	}
+	if config != "" {
+		dirs = append(dirs, filepath.Join(config, "openstack"))
+	}
+
+	return append(dirs, "/etc/openstack")
+}
+
+// findCloudsFile returns the path named by the environment variable env, if it's set, or else the
+// first file called name.yaml or name.yml in the search path. It returns an empty string if there's
+// no such file.
+func findCloudsFile(env, name string) string {
+	if path := os.Getenv(env); path != "" {
+		return path
+	}
+
+	for _, dir := range cloudsSearchPath() {
+		for _, ext := range []string{".yaml", ".yml"} {
+			path := filepath.Join(dir, name+ext)
+			if _, err := os.Stat(path); err == nil {
+				return path
+			}
+		}
+	}
+	return ""
+}
+
+/*
+CloudFromYAML reads the configuration of a cloud from clouds.yaml, in the format shared by the
+OpenStack command-line clients:
+
+  clouds:
+    mycloud:
+      auth:
+        auth_url: https://identity.example.com:5000/v3
+        username: me
+        project_name: my-project
+        user_domain_name: Default
+      region_name: RegionOne
+      interface: internal
+
+The file is looked for in the current directory, then in ~/.config/openstack, then in
+/etc/openstack, unless the OS_CLIENT_CONFIG_FILE environment variable names it explicitly.
+Secrets such as passwords can be kept in a secure.yaml file with the same structure, found the same
+way (or through OS_CLIENT_SECURE_FILE), whose values take precedence.
+
+The cloud is selected by name, or by the OS_CLOUD environment variable if name is empty. Use
+the result to connect to the cloud:
+
+  cloud, err := openstack.CloudFromYAML("mycloud")
+  provider, err := cloud.AuthenticatedClient()
+  client, err := openstack.NewComputeV2(provider, cloud.EndpointOpts())
+
+AuthOptions has a single DomainID and DomainName, which apply to both the user and the project, so
+the first of domain_*, user_domain_* and project_domain_* that's set is used.
+*/
+func CloudFromYAML(name string) (*Cloud, error) {
+	cloudsPath := findCloudsFile("OS_CLIENT_CONFIG_FILE", "clouds")
+	if cloudsPath == "" {
+		return nil, fmt.Errorf("Unable to find a clouds.yaml file in %v.", cloudsSearchPath())
+	}
+	return CloudFromFiles(name, cloudsPath, findCloudsFile("OS_CLIENT_SECURE_FILE", "secure"))
+}
+
+// CloudFromFiles reads the configuration of a cloud from the given clouds.yaml and secure.yaml
+// files, rather than searching for them. securePath is optional. See CloudFromYAML for details.
+func CloudFromFiles(name, cloudsPath, securePath string) (*Cloud, error) {
+	if name == "" {
+		name = os.Getenv("OS_CLOUD")
+	}
+	if name == "" {
+		return nil, fmt.Errorf("A cloud name or the OS_CLOUD environment variable is required.")
+	}
+
+	clouds, err := readCloudsFile(cloudsPath)
+	if err != nil {
+		return nil, err
+	}
+	cloud, ok := clouds[name]
+	if !ok {
+		return nil, fmt.Errorf("Cloud %q wasn't found in %s.", name, cloudsPath)
+	}
+
+	if securePath != "" {
+		secure, err := readCloudsFile(securePath)
+		if err != nil && !os.IsNotExist(err) {
+			return nil, err
+		}
+		if override, ok := secure[name]; ok {
+			cloud = mergeYAML(cloud, override)
+		}
+	}
+
+	// Round-trip the merged values through YAML to decode them into their typed form.
+	b, err := yaml.Marshal(cloud)
+	if err != nil {
+		return nil, err
+	}
+	var entry cloudEntry
+	if err := yaml.Unmarshal(b, &entry); err != nil {
+		return nil, fmt.Errorf("Cloud %q in %s is invalid: %s", name, cloudsPath, err)
+	}
+
+	return newCloud(name, entry), nil
+}
+
+// readCloudsFile parses a clouds.yaml or secure.yaml file, leaving each cloud's configuration
+// untyped so that the two can be merged.
+func readCloudsFile(path string) (map[string]interface{}, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var parsed struct {
+		Clouds map[string]interface{} `yaml:"clouds"`
+	}
+	if err := yaml.Unmarshal(b, &parsed); err != nil {
+		return nil, fmt.Errorf("Unable to parse %s: %s", path, err)
+	}
+	return parsed.Clouds, nil
+}
+
+// mergeYAML merges override into base recursively. Values from override win, except that nested
+// mappings are merged key by key.
+func mergeYAML(base, override interface{}) interface{} {
+	baseMap, ok := base.(map[interface{}]interface{})
+	if !ok {
+		return override
+	}
+	overrideMap, ok := override.(map[interface{}]interface{})
+	if !ok {
+		return override
+	}
+
+	merged := make(map[interface{}]interface{}, len(baseMap))
+	for k, v := range baseMap {
+		merged[k] = v
+	}
+	for k, v := range overrideMap {
+		if existing, ok := merged[k]; ok {
+			merged[k] = mergeYAML(existing, v)
+		} else {
+			merged[k] = v
+		}
+	}
+	return merged
+}
+
+func newCloud(name string, entry cloudEntry) *Cloud {
+	auth := entry.Auth
+	cloud := &Cloud{
+		Name: name,
+		AuthOptions: gophercloud.AuthOptions{
+			IdentityEndpoint: auth.AuthURL,
+			Username:         auth.Username,
+			UserID:           auth.UserID,
+			Password:         auth.Password,
+			APIKey:           auth.APIKey,
+			TenantID:         firstNonEmpty(auth.ProjectID, auth.TenantID),
+			TenantName:       firstNonEmpty(auth.ProjectName, auth.TenantName),
+			DomainID:         firstNonEmpty(auth.DomainID, auth.UserDomainID, auth.ProjectDomainID),
+			DomainName:       firstNonEmpty(auth.DomainName, auth.UserDomainName, auth.ProjectDomainName),
+			TokenID:          auth.Token,
+			Passcode:         auth.Passcode,
+
+			ApplicationCredentialID:     auth.ApplicationCredentialID,
+			ApplicationCredentialName:   auth.ApplicationCredentialName,
+			ApplicationCredentialSecret: auth.ApplicationCredentialSecret,
+		},
+		IdentityAPIVersion: entry.IdentityAPIVersion,
+		RegionName:         entry.RegionName,
+		Interface:          gophercloud.Availability(firstNonEmpty(entry.Interface, entry.EndpointType)),
+		CACertFile:         entry.CACert,
+		ClientCertFile:     entry.Cert,
+		ClientKeyFile:      entry.Key,
+		Insecure:           entry.Verify != nil && !*entry.Verify,
+	}
+
+	// The command-line clients accept "publicURL" and friends, for the benefit of identity v2 users.
+	switch cloud.Interface {
+	case "", "publicURL":
+		cloud.Interface = gophercloud.AvailabilityPublic
+	case "internalURL":
+		cloud.Interface = gophercloud.AvailabilityInternal
+	case "adminURL":
+		cloud.Interface = gophercloud.AvailabilityAdmin
+	}
+
+	return cloud
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// EndpointOpts returns the region and interface that the cloud's service clients should use. Pass
+// it to the NewXxx functions of this package.
+func (c *Cloud) EndpointOpts() gophercloud.EndpointOpts {
+	return gophercloud.EndpointOpts{
+		Region:       c.RegionName,
+		Availability: c.Interface,
+	}
+}
+
+// TLSConfig returns the TLS settings implied by the cloud's cacert, cert, key and verify options,
+// or nil if the defaults apply.
+func (c *Cloud) TLSConfig() (*tls.Config, error) {
+	if c.CACertFile == "" && c.ClientCertFile == "" && !c.Insecure {
+		return nil, nil
+	}
+
+	config := &tls.Config{InsecureSkipVerify: c.Insecure}
+
+	if c.CACertFile != "" {
+		pem, err := ioutil.ReadFile(c.CACertFile)
+		if err != nil {
+			return nil, err
+		}
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("No certificates were found in %s.", c.CACertFile)
+		}
+		config.RootCAs = pool
+	}
+
+	if c.ClientCertFile != "" {
+		cert, err := tls.LoadX509KeyPair(c.ClientCertFile, c.ClientKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+
+	return config, nil
+}
+
+// NewClient prepares an unauthenticated ProviderClient for the cloud, configured with its TLS
+// settings.
+func (c *Cloud) NewClient() (*gophercloud.ProviderClient, error) {
+	client, err := NewClient(c.AuthOptions.IdentityEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := c.TLSConfig()
+	if err != nil {
+		return nil, err
+	}
+	if config != nil {
+		transport := &http.Transport{
+			Proxy:               http.ProxyFromEnvironment,
+			TLSClientConfig:     config,
+			TLSHandshakeTimeout: 10 * time.Second,
+		}
+		client.HTTPClient.Transport = transport
+	}
+
+	return client, nil
+}
+
+// AuthenticatedClient logs in to the cloud and returns a ProviderClient that's ready to operate,
+// using the version of the identity service given by identity_api_version if it's set.
+func (c *Cloud) AuthenticatedClient() (*gophercloud.ProviderClient, error) {
+	client, err := c.NewClient()
+	if err != nil {
+		return nil, err
+	}
+
+	switch c.IdentityAPIVersion {
+	case "":
+		err = Authenticate(client, c.AuthOptions)
+	case "2", "2.0":
+		err = AuthenticateV2(client, c.AuthOptions)
+	case "3":
+		err = AuthenticateV3(client, c.AuthOptions)
+	default:
+		err = fmt.Errorf("Unsupported identity_api_version %q. Supported versions: 2.0, 3", c.IdentityAPIVersion)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return client, nil
+}
@@ -0,0 +1,137 @@
+package openstack
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/rackspace/gophercloud"
+	th "github.com/rackspace/gophercloud/testhelper"
+)
+
+const cloudsYAML = `
+clouds:
+  production:
+    auth:
+      auth_url: https://identity.example.com:5000/v3
+      username: me
+      password: not-the-real-one
+      project_name: web
+      user_domain_name: Default
+    region_name: RegionOne
+    interface: internal
+    identity_api_version: 3
+  legacy:
+    auth:
+      auth_url: https://legacy.example.com:5000/v2.0
+      username: me
+      tenant_id: 1234
+    endpoint_type: adminURL
+    verify: false
+  ci:
+    auth:
+      auth_url: https://identity.example.com:5000/v3
+      application_credential_id: ac1
+      application_credential_secret: s3cr3t
+    identity_api_version: 3
+`
+
+const secureYAML = `
+clouds:
+  production:
+    auth:
+      password: swordfish
+`
+
+func writeCloudsFiles(t *testing.T) (string, func()) {
+	dir, err := ioutil.TempDir("", "gophercloud-clouds")
+	th.AssertNoErr(t, err)
+	th.AssertNoErr(t, ioutil.WriteFile(filepath.Join(dir, "clouds.yaml"), []byte(cloudsYAML), 0600))
+	th.AssertNoErr(t, ioutil.WriteFile(filepath.Join(dir, "secure.yaml"), []byte(secureYAML), 0600))
+	return dir, func() { os.RemoveAll(dir) }
+}
+
+func TestCloudFromFiles(t *testing.T) {
+	dir, cleanup := writeCloudsFiles(t)
+	defer cleanup()
+
+	cloud, err := CloudFromFiles("production", filepath.Join(dir, "clouds.yaml"), filepath.Join(dir, "secure.yaml"))
+	th.AssertNoErr(t, err)
+
+	th.CheckDeepEquals(t, &Cloud{
+		Name: "production",
+		AuthOptions: gophercloud.AuthOptions{
+			IdentityEndpoint: "https://identity.example.com:5000/v3",
+			Username:         "me",
+			Password:         "swordfish",
+			TenantName:       "web",
+			DomainName:       "Default",
+		},
+		IdentityAPIVersion: "3",
+		RegionName:         "RegionOne",
+		Interface:          gophercloud.AvailabilityInternal,
+	}, cloud)
+	th.CheckDeepEquals(t, gophercloud.EndpointOpts{Region: "RegionOne", Availability: gophercloud.AvailabilityInternal}, cloud.EndpointOpts())
+
+	config, err := cloud.TLSConfig()
+	th.AssertNoErr(t, err)
+	if config != nil {
+		t.Errorf("Expected the default TLS configuration, got %#v", config)
+	}
+}
+
+func TestCloudFromFilesLegacyOptions(t *testing.T) {
+	dir, cleanup := writeCloudsFiles(t)
+	defer cleanup()
+
+	cloud, err := CloudFromFiles("legacy", filepath.Join(dir, "clouds.yaml"), "")
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "1234", cloud.AuthOptions.TenantID)
+	th.CheckEquals(t, gophercloud.AvailabilityAdmin, cloud.Interface)
+	th.CheckEquals(t, true, cloud.Insecure)
+
+	config, err := cloud.TLSConfig()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, true, config.InsecureSkipVerify)
+
+	_, err = CloudFromFiles("missing", filepath.Join(dir, "clouds.yaml"), "")
+	th.AssertErr(t, err)
+}
+
+func TestCloudFromFilesApplicationCredential(t *testing.T) {
+	dir, cleanup := writeCloudsFiles(t)
+	defer cleanup()
+
+	cloud, err := CloudFromFiles("ci", filepath.Join(dir, "clouds.yaml"), "")
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, gophercloud.AuthOptions{
+		IdentityEndpoint:            "https://identity.example.com:5000/v3",
+		ApplicationCredentialID:     "ac1",
+		ApplicationCredentialSecret: "s3cr3t",
+	}, cloud.AuthOptions)
+}
+
+func TestCloudFromYAMLSearchesConfigDirectory(t *testing.T) {
+	dir, cleanup := writeCloudsFiles(t)
+	defer cleanup()
+
+	config := filepath.Join(dir, "config")
+	th.AssertNoErr(t, os.MkdirAll(filepath.Join(config, "openstack"), 0700))
+	th.AssertNoErr(t, os.Rename(filepath.Join(dir, "clouds.yaml"), filepath.Join(config, "openstack", "clouds.yaml")))
+
+	for env, value := range map[string]string{
+		"XDG_CONFIG_HOME":       config,
+		"OS_CLIENT_CONFIG_FILE": "",
+		"OS_CLIENT_SECURE_FILE": filepath.Join(dir, "secure.yaml"),
+		"OS_CLOUD":              "production",
+	} {
+		defer os.Setenv(env, os.Getenv(env))
+		os.Setenv(env, value)
+	}
+
+	cloud, err := CloudFromYAML("")
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "production", cloud.Name)
+	th.CheckEquals(t, "swordfish", cloud.AuthOptions.Password)
+}
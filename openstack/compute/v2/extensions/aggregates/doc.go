@@ -0,0 +1,28 @@
+/*
+Package aggregates provides the ability to manage the host aggregates of the
+OpenStack Compute service. It requires administrative rights.
+
+An aggregate groups compute hosts and attaches metadata to them, which the
+scheduler matches against flavor extra specs. An aggregate that has an
+availability zone also exposes its hosts to users as that zone:
+
+	aggregate, err := aggregates.Create(computeClient, aggregates.CreateOpts{
+		Name:             "ssd-hosts",
+		AvailabilityZone: "fast",
+	}).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	aggregate, err = aggregates.AddHost(computeClient, aggregate.ID, aggregates.AddHostOpts{
+		Host: "compute-01",
+	}).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	aggregate, err = aggregates.SetMetadata(computeClient, aggregate.ID, aggregates.SetMetadataOpts{
+		Metadata: map[string]interface{}{"ssd": "true"},
+	}).Extract()
+*/
+package aggregates
@@ -0,0 +1,273 @@
+// +build fixtures
+
+package aggregates
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/rackspace/gophercloud/testhelper"
+	"github.com/rackspace/gophercloud/testhelper/client"
+)
+
+// ListOutput is a sample response to a List call.
+const ListOutput = `
+{
+    "aggregates": [
+        {
+            "id": 1,
+            "name": "ssd-hosts",
+            "availability_zone": "fast",
+            "hosts": [
+                "compute-01"
+            ],
+            "metadata": {
+                "availability_zone": "fast",
+                "ssd": "true"
+            },
+            "created_at": "2016-12-27T22:51:32.000000",
+            "updated_at": null,
+            "deleted_at": null,
+            "deleted": false
+        },
+        {
+            "id": 2,
+            "name": "gpu-hosts",
+            "availability_zone": null,
+            "hosts": [],
+            "metadata": {},
+            "created_at": "2016-12-27T22:52:10.000000",
+            "updated_at": null,
+            "deleted_at": null,
+            "deleted": false
+        }
+    ]
+}
+`
+
+// GetOutput is a sample response to a Get call.
+const GetOutput = `
+{
+    "aggregate": {
+        "id": 1,
+        "name": "ssd-hosts",
+        "availability_zone": "fast",
+        "hosts": [
+            "compute-01"
+        ],
+        "metadata": {
+            "availability_zone": "fast",
+            "ssd": "true"
+        },
+        "created_at": "2016-12-27T22:51:32.000000",
+        "updated_at": null,
+        "deleted_at": null,
+        "deleted": false
+    }
+}
+`
+
+// CreateOutput is a sample response to a Create call.
+const CreateOutput = `
+{
+    "aggregate": {
+        "id": 1,
+        "name": "ssd-hosts",
+        "availability_zone": "fast",
+        "created_at": "2016-12-27T22:51:32.000000",
+        "updated_at": null,
+        "deleted_at": null,
+        "deleted": false
+    }
+}
+`
+
+// UpdateOutput is a sample response to an Update call.
+const UpdateOutput = `
+{
+    "aggregate": {
+        "id": 1,
+        "name": "fast-hosts",
+        "availability_zone": "fast",
+        "hosts": [
+            "compute-01"
+        ],
+        "metadata": {
+            "availability_zone": "fast",
+            "ssd": "true"
+        },
+        "created_at": "2016-12-27T22:51:32.000000",
+        "updated_at": "2016-12-27T23:10:05.000000",
+        "deleted_at": null,
+        "deleted": false
+    }
+}
+`
+
+// RemoveHostOutput is a sample response to a RemoveHost call.
+const RemoveHostOutput = `
+{
+    "aggregate": {
+        "id": 1,
+        "name": "ssd-hosts",
+        "availability_zone": "fast",
+        "hosts": [],
+        "metadata": {
+            "availability_zone": "fast",
+            "ssd": "true"
+        },
+        "created_at": "2016-12-27T22:51:32.000000",
+        "updated_at": null,
+        "deleted_at": null,
+        "deleted": false
+    }
+}
+`
+
+// FirstAggregate is the first result in ListOutput, and the parsed result from GetOutput.
+var FirstAggregate = Aggregate{
+	ID:               1,
+	Name:             "ssd-hosts",
+	AvailabilityZone: "fast",
+	Hosts:            []string{"compute-01"},
+	Metadata: map[string]string{
+		"availability_zone": "fast",
+		"ssd":               "true",
+	},
+	CreatedAt: "2016-12-27T22:51:32.000000",
+}
+
+// SecondAggregate is the second result in ListOutput.
+var SecondAggregate = Aggregate{
+	ID:        2,
+	Name:      "gpu-hosts",
+	Hosts:     []string{},
+	Metadata:  map[string]string{},
+	CreatedAt: "2016-12-27T22:52:10.000000",
+}
+
+// ExpectedAggregateSlice is the slice of results that should be parsed from ListOutput, in the
+// expected order.
+var ExpectedAggregateSlice = []Aggregate{FirstAggregate, SecondAggregate}
+
+// CreatedAggregate is the parsed result from CreateOutput.
+var CreatedAggregate = Aggregate{
+	ID:               1,
+	Name:             "ssd-hosts",
+	AvailabilityZone: "fast",
+	CreatedAt:        "2016-12-27T22:51:32.000000",
+}
+
+// UpdatedAggregate is the parsed result from UpdateOutput.
+var UpdatedAggregate = Aggregate{
+	ID:               1,
+	Name:             "fast-hosts",
+	AvailabilityZone: "fast",
+	Hosts:            []string{"compute-01"},
+	Metadata: map[string]string{
+		"availability_zone": "fast",
+		"ssd":               "true",
+	},
+	CreatedAt: "2016-12-27T22:51:32.000000",
+	UpdatedAt: "2016-12-27T23:10:05.000000",
+}
+
+// AggregateWithoutHosts is the parsed result from RemoveHostOutput.
+var AggregateWithoutHosts = Aggregate{
+	ID:               1,
+	Name:             "ssd-hosts",
+	AvailabilityZone: "fast",
+	Hosts:            []string{},
+	Metadata: map[string]string{
+		"availability_zone": "fast",
+		"ssd":               "true",
+	},
+	CreatedAt: "2016-12-27T22:51:32.000000",
+}
+
+// HandleListSuccessfully configures the test server to respond to a List request.
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-aggregates", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, ListOutput)
+	})
+}
+
+// HandleGetSuccessfully configures the test server to respond to a Get request for an existing
+// aggregate.
+func HandleGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-aggregates/1", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, GetOutput)
+	})
+}
+
+// HandleCreateSuccessfully configures the test server to respond to a Create request for a new
+// aggregate.
+func HandleCreateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-aggregates", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, `
+{
+    "aggregate": {
+        "name": "ssd-hosts",
+        "availability_zone": "fast"
+    }
+}
+`)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, CreateOutput)
+	})
+}
+
+// HandleUpdateSuccessfully configures the test server to respond to an Update request for an
+// existing aggregate.
+func HandleUpdateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-aggregates/1", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, `
+{
+    "aggregate": {
+        "name": "fast-hosts"
+    }
+}
+`)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, UpdateOutput)
+	})
+}
+
+// HandleDeleteSuccessfully configures the test server to respond to a Delete request for an
+// existing aggregate.
+func HandleDeleteSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-aggregates/1", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusOK)
+	})
+}
+
+// HandleActionSuccessfully configures the test server to respond to an aggregate action request
+// with the given body.
+func HandleActionSuccessfully(t *testing.T, request, response string) {
+	th.Mux.HandleFunc("/os-aggregates/1/action", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, request)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, response)
+	})
+}
@@ -0,0 +1,234 @@
+package aggregates
+
+import (
+	"errors"
+
+	"github.com/rackspace/gophercloud"
+	"github.com/rackspace/gophercloud/pagination"
+)
+
+// List returns a Pager that allows you to iterate over a collection of Aggregates.
+func List(client *gophercloud.ServiceClient) pagination.Pager {
+	return pagination.NewPager(client, listURL(client), func(r pagination.PageResult) pagination.Page {
+		return AggregatesPage{pagination.SinglePageBase(r)}
+	})
+}
+
+// CreateOptsBuilder describes struct types that can be accepted by the Create call. Notably, the
+// CreateOpts struct in this package does.
+type CreateOptsBuilder interface {
+	ToAggregateCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts specifies an Aggregate creation request.
+type CreateOpts struct {
+	// Name is the name of the aggregate. It's required.
+	Name string
+
+	// AvailabilityZone, if provided, is the availability zone that the aggregate's hosts are
+	// exposed as.
+	AvailabilityZone string
+}
+
+// ToAggregateCreateMap constructs a request body from CreateOpts.
+func (opts CreateOpts) ToAggregateCreateMap() (map[string]interface{}, error) {
+	if opts.Name == "" {
+		return nil, errors.New("Missing field required for aggregate creation: Name")
+	}
+
+	aggregate := make(map[string]interface{})
+	aggregate["name"] = opts.Name
+	if opts.AvailabilityZone != "" {
+		aggregate["availability_zone"] = opts.AvailabilityZone
+	}
+
+	return map[string]interface{}{"aggregate": aggregate}, nil
+}
+
+// Create requests the creation of a new Aggregate.
+func Create(client *gophercloud.ServiceClient, opts CreateOptsBuilder) CreateResult {
+	var res CreateResult
+
+	reqBody, err := opts.ToAggregateCreateMap()
+	if err != nil {
+		res.Err = err
+		return res
+	}
+
+	_, res.Err = client.Post(createURL(client), reqBody, &res.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return res
+}
+
+// Get returns data about a previously created Aggregate.
+func Get(client *gophercloud.ServiceClient, id int) GetResult {
+	var res GetResult
+	_, res.Err = client.Get(getURL(client, id), &res.Body, nil)
+	return res
+}
+
+// UpdateOptsBuilder describes struct types that can be accepted by the Update call. Notably, the
+// UpdateOpts struct in this package does.
+type UpdateOptsBuilder interface {
+	ToAggregateUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts specifies the changes to make to an Aggregate.
+type UpdateOpts struct {
+	// Name, if provided, renames the aggregate.
+	Name string
+
+	// AvailabilityZone, if provided, changes the availability zone that the aggregate's hosts are
+	// exposed as.
+	AvailabilityZone string
+}
+
+// ToAggregateUpdateMap constructs a request body from UpdateOpts.
+func (opts UpdateOpts) ToAggregateUpdateMap() (map[string]interface{}, error) {
+	if opts.Name == "" && opts.AvailabilityZone == "" {
+		return nil, errors.New("At least one of Name and AvailabilityZone must be provided for an aggregate update")
+	}
+
+	aggregate := make(map[string]interface{})
+	if opts.Name != "" {
+		aggregate["name"] = opts.Name
+	}
+	if opts.AvailabilityZone != "" {
+		aggregate["availability_zone"] = opts.AvailabilityZone
+	}
+
+	return map[string]interface{}{"aggregate": aggregate}, nil
+}
+
+// Update changes the name or availability zone of an Aggregate.
+func Update(client *gophercloud.ServiceClient, id int, opts UpdateOptsBuilder) UpdateResult {
+	var res UpdateResult
+
+	reqBody, err := opts.ToAggregateUpdateMap()
+	if err != nil {
+		res.Err = err
+		return res
+	}
+
+	_, res.Err = client.Put(updateURL(client, id), reqBody, &res.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return res
+}
+
+// Delete requests the deletion of a previously created Aggregate. The aggregate must not have any
+// hosts left.
+func Delete(client *gophercloud.ServiceClient, id int) DeleteResult {
+	var res DeleteResult
+	_, res.Err = client.Delete(deleteURL(client, id), &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return res
+}
+
+// AddHostOptsBuilder describes struct types that can be accepted by the AddHost call.
+type AddHostOptsBuilder interface {
+	ToAggregateAddHostMap() (map[string]interface{}, error)
+}
+
+// AddHostOpts identifies the compute host to add to an Aggregate.
+type AddHostOpts struct {
+	// Host is the name of the compute host. It's required.
+	Host string
+}
+
+// ToAggregateAddHostMap constructs a request body from AddHostOpts.
+func (opts AddHostOpts) ToAggregateAddHostMap() (map[string]interface{}, error) {
+	if opts.Host == "" {
+		return nil, errors.New("Missing field required for adding a host to an aggregate: Host")
+	}
+	return map[string]interface{}{"add_host": map[string]interface{}{"host": opts.Host}}, nil
+}
+
+// AddHost adds a compute host to an Aggregate.
+func AddHost(client *gophercloud.ServiceClient, id int, opts AddHostOptsBuilder) ActionResult {
+	var res ActionResult
+
+	reqBody, err := opts.ToAggregateAddHostMap()
+	if err != nil {
+		res.Err = err
+		return res
+	}
+
+	_, res.Err = client.Post(actionURL(client, id), reqBody, &res.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return res
+}
+
+// RemoveHostOptsBuilder describes struct types that can be accepted by the RemoveHost call.
+type RemoveHostOptsBuilder interface {
+	ToAggregateRemoveHostMap() (map[string]interface{}, error)
+}
+
+// RemoveHostOpts identifies the compute host to remove from an Aggregate.
+type RemoveHostOpts struct {
+	// Host is the name of the compute host. It's required.
+	Host string
+}
+
+// ToAggregateRemoveHostMap constructs a request body from RemoveHostOpts.
+func (opts RemoveHostOpts) ToAggregateRemoveHostMap() (map[string]interface{}, error) {
+	if opts.Host == "" {
+		return nil, errors.New("Missing field required for removing a host from an aggregate: Host")
+	}
+	return map[string]interface{}{"remove_host": map[string]interface{}{"host": opts.Host}}, nil
+}
+
+// RemoveHost removes a compute host from an Aggregate.
+func RemoveHost(client *gophercloud.ServiceClient, id int, opts RemoveHostOptsBuilder) ActionResult {
+	var res ActionResult
+
+	reqBody, err := opts.ToAggregateRemoveHostMap()
+	if err != nil {
+		res.Err = err
+		return res
+	}
+
+	_, res.Err = client.Post(actionURL(client, id), reqBody, &res.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return res
+}
+
+// SetMetadataOptsBuilder describes struct types that can be accepted by the SetMetadata call.
+type SetMetadataOptsBuilder interface {
+	ToAggregateSetMetadataMap() (map[string]interface{}, error)
+}
+
+// SetMetadataOpts specifies the metadata to set on an Aggregate.
+type SetMetadataOpts struct {
+	// Metadata maps keys to their new values. Keys that aren't in it are left as they are, and keys
+	// whose value is nil are removed.
+	Metadata map[string]interface{}
+}
+
+// ToAggregateSetMetadataMap constructs a request body from SetMetadataOpts.
+func (opts SetMetadataOpts) ToAggregateSetMetadataMap() (map[string]interface{}, error) {
+	if len(opts.Metadata) == 0 {
+		return nil, errors.New("Missing field required for setting aggregate metadata: Metadata")
+	}
+	return map[string]interface{}{"set_metadata": map[string]interface{}{"metadata": opts.Metadata}}, nil
+}
+
+// SetMetadata adds, changes or removes metadata of an Aggregate.
+func SetMetadata(client *gophercloud.ServiceClient, id int, opts SetMetadataOptsBuilder) ActionResult {
+	var res ActionResult
+
+	reqBody, err := opts.ToAggregateSetMetadataMap()
+	if err != nil {
+		res.Err = err
+		return res
+	}
+
+	_, res.Err = client.Post(actionURL(client, id), reqBody, &res.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return res
+}
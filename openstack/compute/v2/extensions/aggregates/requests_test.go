@@ -0,0 +1,108 @@
+package aggregates
+
+import (
+	"testing"
+
+	"github.com/rackspace/gophercloud/pagination"
+	th "github.com/rackspace/gophercloud/testhelper"
+	"github.com/rackspace/gophercloud/testhelper/client"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	count := 0
+	err := List(client.ServiceClient()).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		actual, err := ExtractAggregates(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, ExpectedAggregateSlice, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 1, count)
+}
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateSuccessfully(t)
+
+	actual, err := Create(client.ServiceClient(), CreateOpts{
+		Name:             "ssd-hosts",
+		AvailabilityZone: "fast",
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &CreatedAggregate, actual)
+}
+
+func TestCreateRequiresName(t *testing.T) {
+	res := Create(client.ServiceClient(), CreateOpts{AvailabilityZone: "fast"})
+	if res.Err == nil {
+		t.Fatalf("Expected an error for an aggregate without a name")
+	}
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t)
+
+	actual, err := Get(client.ServiceClient(), 1).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstAggregate, actual)
+}
+
+func TestUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdateSuccessfully(t)
+
+	actual, err := Update(client.ServiceClient(), 1, UpdateOpts{Name: "fast-hosts"}).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &UpdatedAggregate, actual)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteSuccessfully(t)
+
+	err := Delete(client.ServiceClient(), 1).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestAddHost(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleActionSuccessfully(t, `{"add_host": {"host": "compute-01"}}`, GetOutput)
+
+	actual, err := AddHost(client.ServiceClient(), 1, AddHostOpts{Host: "compute-01"}).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstAggregate, actual)
+}
+
+func TestRemoveHost(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleActionSuccessfully(t, `{"remove_host": {"host": "compute-01"}}`, RemoveHostOutput)
+
+	actual, err := RemoveHost(client.ServiceClient(), 1, RemoveHostOpts{Host: "compute-01"}).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &AggregateWithoutHosts, actual)
+}
+
+func TestSetMetadata(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleActionSuccessfully(t, `{"set_metadata": {"metadata": {"ssd": "true", "hdd": null}}}`, GetOutput)
+
+	actual, err := SetMetadata(client.ServiceClient(), 1, SetMetadataOpts{
+		Metadata: map[string]interface{}{"ssd": "true", "hdd": nil},
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstAggregate, actual)
+}
@@ -0,0 +1,105 @@
+package aggregates
+
+import (
+	"github.com/mitchellh/mapstructure"
+	"github.com/rackspace/gophercloud"
+	"github.com/rackspace/gophercloud/pagination"
+)
+
+// An Aggregate is a group of compute hosts that share metadata.
+type Aggregate struct {
+	// ID is the unique ID of the aggregate.
+	ID int `mapstructure:"id"`
+
+	// UUID is the UUID of the aggregate. It's only set from microversion 2.41 on.
+	UUID string `mapstructure:"uuid"`
+
+	// Name is the name of the aggregate.
+	Name string `mapstructure:"name"`
+
+	// AvailabilityZone is the availability zone that the aggregate's hosts are exposed as, if any.
+	AvailabilityZone string `mapstructure:"availability_zone"`
+
+	// Hosts are the names of the compute hosts in the aggregate.
+	Hosts []string `mapstructure:"hosts"`
+
+	// Metadata includes all key-value pairs attached to the aggregate, including its
+	// "availability_zone".
+	Metadata map[string]string `mapstructure:"metadata"`
+
+	// CreatedAt and UpdatedAt are when the aggregate was created and last changed.
+	CreatedAt string `mapstructure:"created_at"`
+	UpdatedAt string `mapstructure:"updated_at"`
+}
+
+// AggregatesPage stores a single, only page of Aggregates results from a List call.
+type AggregatesPage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty determines whether or not an AggregatesPage is empty.
+func (page AggregatesPage) IsEmpty() (bool, error) {
+	aggregates, err := ExtractAggregates(page)
+	return len(aggregates) == 0, err
+}
+
+// ExtractAggregates interprets a page of results as a slice of Aggregates.
+func ExtractAggregates(page pagination.Page) ([]Aggregate, error) {
+	casted := page.(AggregatesPage).Body
+	var response struct {
+		Aggregates []Aggregate `mapstructure:"aggregates"`
+	}
+
+	err := mapstructure.Decode(casted, &response)
+	return response.Aggregates, err
+}
+
+// AggregateResult is the response from any operation that returns a single Aggregate.
+type AggregateResult struct {
+	gophercloud.Result
+}
+
+// Extract is a method that attempts to interpret any Aggregate resource response as an Aggregate
+// struct.
+func (r AggregateResult) Extract() (*Aggregate, error) {
+	if r.Err != nil {
+		return nil, r.Err
+	}
+
+	var res struct {
+		Aggregate *Aggregate `mapstructure:"aggregate"`
+	}
+
+	err := mapstructure.Decode(r.Body, &res)
+	return res.Aggregate, err
+}
+
+// CreateResult is the response from a Create operation. Call its Extract method to interpret it
+// as an Aggregate.
+type CreateResult struct {
+	AggregateResult
+}
+
+// GetResult is the response from a Get operation. Call its Extract method to interpret it
+// as an Aggregate.
+type GetResult struct {
+	AggregateResult
+}
+
+// UpdateResult is the response from an Update operation. Call its Extract method to interpret it
+// as an Aggregate.
+type UpdateResult struct {
+	AggregateResult
+}
+
+// ActionResult is the response from an AddHost, RemoveHost or SetMetadata operation. Call its
+// Extract method to interpret it as the changed Aggregate.
+type ActionResult struct {
+	AggregateResult
+}
+
+// DeleteResult is the response from a Delete operation. Call its ExtractErr method to determine if
+// the call succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
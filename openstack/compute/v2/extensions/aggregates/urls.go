@@ -0,0 +1,37 @@
+package aggregates
+
+import (
+	"strconv"
+
+	"github.com/rackspace/gophercloud"
+)
+
+const resourcePath = "os-aggregates"
+
+func resourceURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(resourcePath)
+}
+
+func listURL(c *gophercloud.ServiceClient) string {
+	return resourceURL(c)
+}
+
+func createURL(c *gophercloud.ServiceClient) string {
+	return resourceURL(c)
+}
+
+func getURL(c *gophercloud.ServiceClient, id int) string {
+	return c.ServiceURL(resourcePath, strconv.Itoa(id))
+}
+
+func updateURL(c *gophercloud.ServiceClient, id int) string {
+	return getURL(c, id)
+}
+
+func deleteURL(c *gophercloud.ServiceClient, id int) string {
+	return getURL(c, id)
+}
+
+func actionURL(c *gophercloud.ServiceClient, id int) string {
+	return c.ServiceURL(resourcePath, strconv.Itoa(id), "action")
+}
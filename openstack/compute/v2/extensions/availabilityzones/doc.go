@@ -0,0 +1,22 @@
+/*
+Package availabilityzones provides information about the availability zones
+of the OpenStack Compute service.
+
+List returns the zones that users may boot servers in. ListDetail also returns
+the internal zone, and the hosts of every zone with the state of their
+services, which requires administrative rights:
+
+	err := availabilityzones.ListDetail(computeClient).EachPage(func(page pagination.Page) (bool, error) {
+		zones, err := availabilityzones.ExtractAvailabilityZones(page)
+		if err != nil {
+			return false, err
+		}
+		for _, zone := range zones {
+			for host, services := range zone.Hosts {
+				fmt.Println(zone.ZoneName, host, services["nova-compute"].Active)
+			}
+		}
+		return true, nil
+	})
+*/
+package availabilityzones
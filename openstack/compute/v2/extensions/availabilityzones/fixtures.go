@@ -0,0 +1,115 @@
+// +build fixtures
+
+package availabilityzones
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/rackspace/gophercloud/testhelper"
+	"github.com/rackspace/gophercloud/testhelper/client"
+)
+
+// ListOutput is a sample response to a List call.
+const ListOutput = `
+{
+    "availabilityZoneInfo": [
+        {
+            "zoneName": "nova",
+            "zoneState": {
+                "available": true
+            },
+            "hosts": null
+        }
+    ]
+}
+`
+
+// ListDetailOutput is a sample response to a ListDetail call.
+const ListDetailOutput = `
+{
+    "availabilityZoneInfo": [
+        {
+            "zoneName": "internal",
+            "zoneState": {
+                "available": true
+            },
+            "hosts": {
+                "controller-01": {
+                    "nova-scheduler": {
+                        "available": true,
+                        "active": true,
+                        "updated_at": "2016-05-12T12:20:13.000000"
+                    }
+                }
+            }
+        },
+        {
+            "zoneName": "nova",
+            "zoneState": {
+                "available": true
+            },
+            "hosts": {
+                "compute-01": {
+                    "nova-compute": {
+                        "available": true,
+                        "active": true,
+                        "updated_at": "2016-05-12T12:20:10.000000"
+                    }
+                }
+            }
+        }
+    ]
+}
+`
+
+// NovaZone is the only result in ListOutput.
+var NovaZone = AvailabilityZone{
+	ZoneName:  "nova",
+	ZoneState: ZoneState{Available: true},
+}
+
+// ExpectedDetailSlice is the slice of results that should be parsed from ListDetailOutput.
+var ExpectedDetailSlice = []AvailabilityZone{
+	{
+		ZoneName:  "internal",
+		ZoneState: ZoneState{Available: true},
+		Hosts: map[string]map[string]ServiceState{
+			"controller-01": {
+				"nova-scheduler": {Available: true, Active: true, UpdatedAt: "2016-05-12T12:20:13.000000"},
+			},
+		},
+	},
+	{
+		ZoneName:  "nova",
+		ZoneState: ZoneState{Available: true},
+		Hosts: map[string]map[string]ServiceState{
+			"compute-01": {
+				"nova-compute": {Available: true, Active: true, UpdatedAt: "2016-05-12T12:20:10.000000"},
+			},
+		},
+	},
+}
+
+// HandleListSuccessfully configures the test server to respond to a List request.
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-availability-zone", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, ListOutput)
+	})
+}
+
+// HandleListDetailSuccessfully configures the test server to respond to a ListDetail request.
+func HandleListDetailSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-availability-zone/detail", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, ListDetailOutput)
+	})
+}
@@ -0,0 +1,56 @@
+package availabilityzones
+
+import (
+	"github.com/mitchellh/mapstructure"
+	"github.com/rackspace/gophercloud/pagination"
+)
+
+// AvailabilityZone is a group of compute hosts that servers can be booted in.
+type AvailabilityZone struct {
+	// ZoneName is the name of the zone, as servers.CreateOpts.AvailabilityZone expects it.
+	ZoneName string `mapstructure:"zoneName"`
+
+	// ZoneState tells whether the zone has any available hosts.
+	ZoneState ZoneState `mapstructure:"zoneState"`
+
+	// Hosts maps the host names of the zone to their services, keyed by binary. It's only set by
+	// ListDetail.
+	Hosts map[string]map[string]ServiceState `mapstructure:"hosts"`
+}
+
+// ZoneState is the state of an AvailabilityZone.
+type ZoneState struct {
+	Available bool `mapstructure:"available"`
+}
+
+// ServiceState is the state of a service on a host of an AvailabilityZone.
+type ServiceState struct {
+	// Available is true when the service is enabled, and Active when it's up.
+	Available bool `mapstructure:"available"`
+	Active    bool `mapstructure:"active"`
+
+	// UpdatedAt is when the service last reported in.
+	UpdatedAt string `mapstructure:"updated_at"`
+}
+
+// AvailabilityZonePage stores a single, only page of AvailabilityZones from a List or ListDetail
+// call.
+type AvailabilityZonePage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty determines whether or not an AvailabilityZonePage is empty.
+func (page AvailabilityZonePage) IsEmpty() (bool, error) {
+	zones, err := ExtractAvailabilityZones(page)
+	return len(zones) == 0, err
+}
+
+// ExtractAvailabilityZones interprets a page of results as a slice of AvailabilityZones.
+func ExtractAvailabilityZones(page pagination.Page) ([]AvailabilityZone, error) {
+	var response struct {
+		AvailabilityZones []AvailabilityZone `mapstructure:"availabilityZoneInfo"`
+	}
+
+	err := mapstructure.Decode(page.(AvailabilityZonePage).Body, &response)
+	return response.AvailabilityZones, err
+}
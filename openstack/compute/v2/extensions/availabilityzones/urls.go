@@ -0,0 +1,13 @@
+package availabilityzones
+
+import "github.com/rackspace/gophercloud"
+
+const resourcePath = "os-availability-zone"
+
+func listURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(resourcePath)
+}
+
+func listDetailURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(resourcePath, "detail")
+}
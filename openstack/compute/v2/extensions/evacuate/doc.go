@@ -0,0 +1,19 @@
+/*
+Package evacuate provides functionality to evacuate servers from a failed
+compute host of the OpenStack Compute service.
+
+Evacuate rebuilds a server on another host, and is only accepted once the
+compute service of the server's original host is down. While the server is
+rebuilt its status is REBUILD; once the evacuation succeeds it returns to the
+status it had before, usually ACTIVE, which servers.WaitForStatus can wait for:
+
+	pass, err := evacuate.Evacuate(computeClient, serverID, evacuate.EvacuateOpts{
+		OnSharedStorage: true,
+	}).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	err = servers.WaitForStatus(computeClient, serverID, "ACTIVE", 300)
+*/
+package evacuate
@@ -0,0 +1,71 @@
+package evacuate
+
+import (
+	"io"
+
+	"github.com/rackspace/gophercloud"
+)
+
+func actionURL(client *gophercloud.ServiceClient, id string) string {
+	return client.ServiceURL("servers", id, "action")
+}
+
+// EvacuateOptsBuilder allows extensions to add additional parameters to the Evacuate request.
+type EvacuateOptsBuilder interface {
+	ToEvacuateMap() (map[string]interface{}, error)
+}
+
+// EvacuateOpts specifies where and how a server is rebuilt.
+type EvacuateOpts struct {
+	// Host: optional, the host to rebuild the server on. If you omit this parameter, the
+	// scheduler chooses a host.
+	Host string
+
+	// AdminPass: optional, the administrative password of the rebuilt server. If you omit this
+	// parameter and the server isn't on shared storage, a password is generated.
+	AdminPass string
+
+	// OnSharedStorage: set to true when the server's disks are on storage shared with the
+	// destination host, so the server keeps its disks instead of being rebuilt from its image. It's
+	// only sent when true, and only accepted below microversion 2.14; from 2.14 on, the service
+	// detects shared storage itself.
+	OnSharedStorage bool
+}
+
+// ToEvacuateMap assembles a request body based on the contents of an EvacuateOpts.
+func (opts EvacuateOpts) ToEvacuateMap() (map[string]interface{}, error) {
+	evacuate := make(map[string]interface{})
+	if opts.OnSharedStorage {
+		evacuate["onSharedStorage"] = true
+	}
+	if opts.Host != "" {
+		evacuate["host"] = opts.Host
+	}
+	if opts.AdminPass != "" {
+		evacuate["adminPass"] = opts.AdminPass
+	}
+
+	return map[string]interface{}{"evacuate": evacuate}, nil
+}
+
+// Evacuate rebuilds a server from a failed host on another host. Its status becomes REBUILD,
+// and then returns to the status it had before the host failed. From microversion 2.14 on, the
+// response has no body.
+func Evacuate(client *gophercloud.ServiceClient, id string, opts EvacuateOptsBuilder) EvacuateResult {
+	var res EvacuateResult
+
+	reqBody, err := opts.ToEvacuateMap()
+	if err != nil {
+		res.Err = err
+		return res
+	}
+
+	_, err = client.Post(actionURL(client, id), reqBody, &res.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	// From microversion 2.14 on, the response has no body to decode.
+	if err != io.EOF {
+		res.Err = err
+	}
+	return res
+}
@@ -0,0 +1,37 @@
+package evacuate
+
+import (
+	"testing"
+
+	th "github.com/rackspace/gophercloud/testhelper"
+	"github.com/rackspace/gophercloud/testhelper/client"
+)
+
+const serverID = "{serverId}"
+
+func TestEvacuate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	mockEvacuateServerResponse(t, serverID)
+
+	pass, err := Evacuate(client.ServiceClient(), serverID, EvacuateOpts{
+		Host:      "compute-02",
+		AdminPass: "MySecretPass",
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "MySecretPass", pass)
+}
+
+func TestEvacuateWithoutResponseBody(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	mockEvacuateServerNoContentResponse(t, serverID)
+
+	pass, err := Evacuate(client.ServiceClient(), serverID, EvacuateOpts{
+		OnSharedStorage: true,
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "", pass)
+}
@@ -0,0 +1,27 @@
+package evacuate
+
+import (
+	"github.com/mitchellh/mapstructure"
+	"github.com/rackspace/gophercloud/openstack/compute/v2/servers"
+)
+
+// EvacuateResult represents the result of an Evacuate operation.
+type EvacuateResult struct {
+	servers.ActionResult
+}
+
+// Extract interprets an EvacuateResult as the administrative password of the rebuilt server.
+// The password is empty when the server's disks were on shared storage, and from microversion 2.14
+// on, when the service no longer returns it.
+func (r EvacuateResult) Extract() (string, error) {
+	if r.Err != nil {
+		return "", r.Err
+	}
+
+	var response struct {
+		AdminPass string `mapstructure:"adminPass"`
+	}
+
+	err := mapstructure.Decode(r.Body, &response)
+	return response.AdminPass, err
+}
@@ -0,0 +1,24 @@
+/*
+Package hypervisors provides information about the hypervisors of the
+OpenStack Compute service, such as their capacity and usage, their uptime and
+the servers that run on them. It requires administrative rights.
+
+The Service.Host of a hypervisor is the host name that
+adminactions.LiveMigrateOpts.Host expects, and its capacity fields, such as
+free_ram_mb, are the attributes that schedulerhints.SchedulerHints.Query
+filters on. Listing hypervisors page by page, and hypervisor IDs that are
+UUIDs, require microversion 2.53 or later:
+
+	client := computeClient.WithMicroversion("2.53")
+	err := hypervisors.List(client, hypervisors.ListOpts{Limit: 50}).EachPage(func(page pagination.Page) (bool, error) {
+		hs, err := hypervisors.ExtractHypervisors(page)
+		if err != nil {
+			return false, err
+		}
+		for _, h := range hs {
+			fmt.Printf("%s: %d/%d MB free\n", h.HypervisorHostname, h.FreeRAMMB, h.MemoryMB)
+		}
+		return true, nil
+	})
+*/
+package hypervisors
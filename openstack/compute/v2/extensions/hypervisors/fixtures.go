@@ -0,0 +1,252 @@
+// +build fixtures
+
+package hypervisors
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/rackspace/gophercloud/testhelper"
+	"github.com/rackspace/gophercloud/testhelper/client"
+)
+
+// ListOutput is a sample response to a List call.
+const ListOutput = `
+{
+    "hypervisors": [
+        {
+            "id": 1,
+            "hypervisor_hostname": "compute-01",
+            "state": "up",
+            "status": "enabled",
+            "hypervisor_type": "QEMU",
+            "hypervisor_version": 2011000,
+            "host_ip": "192.168.1.11",
+            "vcpus": 16,
+            "vcpus_used": 4,
+            "memory_mb": 65536,
+            "memory_mb_used": 8704,
+            "free_ram_mb": 56832,
+            "local_gb": 500,
+            "local_gb_used": 60,
+            "free_disk_gb": 440,
+            "disk_available_least": 430,
+            "running_vms": 3,
+            "current_workload": 0,
+            "service": {
+                "id": 6,
+                "host": "compute-01",
+                "disabled_reason": null
+            }
+        }
+    ]
+}
+`
+
+// GetOutput is a sample response to a Get call.
+const GetOutput = `
+{
+    "hypervisor": {
+        "id": 1,
+        "hypervisor_hostname": "compute-01",
+        "state": "up",
+        "status": "enabled",
+        "hypervisor_type": "QEMU",
+        "hypervisor_version": 2011000,
+        "host_ip": "192.168.1.11",
+        "vcpus": 16,
+        "vcpus_used": 4,
+        "memory_mb": 65536,
+        "memory_mb_used": 8704,
+        "free_ram_mb": 56832,
+        "local_gb": 500,
+        "local_gb_used": 60,
+        "free_disk_gb": 440,
+        "disk_available_least": 430,
+        "running_vms": 3,
+        "current_workload": 0,
+        "service": {
+            "id": 6,
+            "host": "compute-01",
+            "disabled_reason": null
+        }
+    }
+}
+`
+
+// StatisticsOutput is a sample response to a GetStatistics call.
+const StatisticsOutput = `
+{
+    "hypervisor_statistics": {
+        "count": 2,
+        "vcpus": 32,
+        "vcpus_used": 6,
+        "memory_mb": 131072,
+        "memory_mb_used": 12800,
+        "free_ram_mb": 118272,
+        "local_gb": 1000,
+        "local_gb_used": 80,
+        "free_disk_gb": 920,
+        "disk_available_least": 900,
+        "running_vms": 5,
+        "current_workload": 1
+    }
+}
+`
+
+// UptimeOutput is a sample response to a GetUptime call.
+const UptimeOutput = `
+{
+    "hypervisor": {
+        "id": 1,
+        "hypervisor_hostname": "compute-01",
+        "state": "up",
+        "status": "enabled",
+        "uptime": " 08:32:11 up 93 days, 18:25, 12 users,  load average: 0.20, 0.12, 0.14"
+    }
+}
+`
+
+// ServersOutput is a sample response to a ListServers call.
+const ServersOutput = `
+{
+    "hypervisors": [
+        {
+            "id": 1,
+            "hypervisor_hostname": "compute-01",
+            "state": "up",
+            "status": "enabled",
+            "servers": [
+                {
+                    "uuid": "e0a4a46d-7a1a-4d76-a1c5-5c2a3e5b6a01",
+                    "name": "instance-00000001"
+                },
+                {
+                    "uuid": "6f62d5a4-1d7e-4ad2-9f47-2b8c5d8a7e02",
+                    "name": "instance-00000002"
+                }
+            ]
+        }
+    ]
+}
+`
+
+// FirstHypervisor is the parsed result from GetOutput, and the only result in ListOutput.
+var FirstHypervisor = Hypervisor{
+	ID:                 "1",
+	HypervisorHostname: "compute-01",
+	State:              "up",
+	Status:             "enabled",
+	HypervisorType:     "QEMU",
+	HypervisorVersion:  2011000,
+	HostIP:             "192.168.1.11",
+	VCPUs:              16,
+	VCPUsUsed:          4,
+	MemoryMB:           65536,
+	MemoryMBUsed:       8704,
+	FreeRAMMB:          56832,
+	LocalGB:            500,
+	LocalGBUsed:        60,
+	FreeDiskGB:         440,
+	DiskAvailableLeast: 430,
+	RunningVMs:         3,
+	CurrentWorkload:    0,
+	Service: Service{
+		ID:   "6",
+		Host: "compute-01",
+	},
+}
+
+// ExpectedStatistics is the parsed result from StatisticsOutput.
+var ExpectedStatistics = Statistics{
+	Count:              2,
+	VCPUs:              32,
+	VCPUsUsed:          6,
+	MemoryMB:           131072,
+	MemoryMBUsed:       12800,
+	FreeRAMMB:          118272,
+	LocalGB:            1000,
+	LocalGBUsed:        80,
+	FreeDiskGB:         920,
+	DiskAvailableLeast: 900,
+	RunningVMs:         5,
+	CurrentWorkload:    1,
+}
+
+// ExpectedUptime is the parsed result from UptimeOutput.
+var ExpectedUptime = Uptime{
+	ID:                 "1",
+	HypervisorHostname: "compute-01",
+	State:              "up",
+	Status:             "enabled",
+	Uptime:             " 08:32:11 up 93 days, 18:25, 12 users,  load average: 0.20, 0.12, 0.14",
+}
+
+// ExpectedServersHypervisor is the only result in ServersOutput.
+var ExpectedServersHypervisor = Hypervisor{
+	ID:                 "1",
+	HypervisorHostname: "compute-01",
+	State:              "up",
+	Status:             "enabled",
+	Servers: []Server{
+		{UUID: "e0a4a46d-7a1a-4d76-a1c5-5c2a3e5b6a01", Name: "instance-00000001"},
+		{UUID: "6f62d5a4-1d7e-4ad2-9f47-2b8c5d8a7e02", Name: "instance-00000002"},
+	},
+}
+
+// HandleListSuccessfully configures the test server to respond to a List request.
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-hypervisors/detail", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{"limit": "1"})
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, ListOutput)
+	})
+}
+
+// HandleGetSuccessfully configures the test server to respond to a Get request.
+func HandleGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-hypervisors/1", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, GetOutput)
+	})
+}
+
+// HandleStatisticsSuccessfully configures the test server to respond to a GetStatistics request.
+func HandleStatisticsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-hypervisors/statistics", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, StatisticsOutput)
+	})
+}
+
+// HandleUptimeSuccessfully configures the test server to respond to a GetUptime request.
+func HandleUptimeSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-hypervisors/1/uptime", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, UptimeOutput)
+	})
+}
+
+// HandleServersSuccessfully configures the test server to respond to a ListServers request.
+func HandleServersSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-hypervisors/compute-01/servers", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, ServersOutput)
+	})
+}
@@ -0,0 +1,81 @@
+package hypervisors
+
+import (
+	"github.com/rackspace/gophercloud"
+	"github.com/rackspace/gophercloud/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the List request.
+type ListOptsBuilder interface {
+	ToHypervisorListQuery() (string, error)
+}
+
+// ListOpts helps control the results returned by the List function.
+type ListOpts struct {
+	// Marker and Limit control paging. They require microversion 2.33 or later.
+	Marker string `q:"marker"`
+	Limit  int    `q:"limit"`
+
+	// HypervisorHostnamePattern, if provided, only lists hypervisors whose host names contain it.
+	// It requires microversion 2.53 or later.
+	HypervisorHostnamePattern string `q:"hypervisor_hostname_pattern"`
+
+	// WithServers, if true, includes the servers that run on each hypervisor. It requires
+	// microversion 2.53 or later; use ListServers with earlier microversions.
+	WithServers bool `q:"with_servers"`
+}
+
+// ToHypervisorListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToHypervisorListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), nil
+}
+
+// List returns a Pager that allows you to iterate over the hypervisors, with their details.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(client)
+	if opts != nil {
+		query, err := opts.ToHypervisorListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return HypervisorPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get returns the details of a single hypervisor.
+func Get(client *gophercloud.ServiceClient, id string) GetResult {
+	var res GetResult
+	_, res.Err = client.Get(getURL(client, id), &res.Body, nil)
+	return res
+}
+
+// GetStatistics returns the capacity and usage of all hypervisors, summed up.
+func GetStatistics(client *gophercloud.ServiceClient) StatisticsResult {
+	var res StatisticsResult
+	_, res.Err = client.Get(statisticsURL(client), &res.Body, nil)
+	return res
+}
+
+// GetUptime returns how long a hypervisor has been running, as reported by its host.
+func GetUptime(client *gophercloud.ServiceClient, id string) UptimeResult {
+	var res UptimeResult
+	_, res.Err = client.Get(uptimeURL(client, id), &res.Body, nil)
+	return res
+}
+
+// ListServers returns a Pager that allows you to iterate over the hypervisors whose host names
+// contain hostnamePattern, with the servers that run on each of them. It's removed in microversion
+// 2.53; use List with ListOpts.WithServers instead.
+func ListServers(client *gophercloud.ServiceClient, hostnamePattern string) pagination.Pager {
+	return pagination.NewPager(client, serversURL(client, hostnamePattern), func(r pagination.PageResult) pagination.Page {
+		return HypervisorPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
@@ -0,0 +1,75 @@
+package hypervisors
+
+import (
+	"testing"
+
+	"github.com/rackspace/gophercloud/pagination"
+	th "github.com/rackspace/gophercloud/testhelper"
+	"github.com/rackspace/gophercloud/testhelper/client"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	count := 0
+	err := List(client.ServiceClient(), ListOpts{Limit: 1}).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		actual, err := ExtractHypervisors(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, []Hypervisor{FirstHypervisor}, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 1, count)
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t)
+
+	actual, err := Get(client.ServiceClient(), "1").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstHypervisor, actual)
+}
+
+func TestGetStatistics(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleStatisticsSuccessfully(t)
+
+	actual, err := GetStatistics(client.ServiceClient()).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &ExpectedStatistics, actual)
+}
+
+func TestGetUptime(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUptimeSuccessfully(t)
+
+	actual, err := GetUptime(client.ServiceClient(), "1").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &ExpectedUptime, actual)
+}
+
+func TestListServers(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleServersSuccessfully(t)
+
+	count := 0
+	err := ListServers(client.ServiceClient(), "compute-01").EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		actual, err := ExtractHypervisors(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, []Hypervisor{ExpectedServersHypervisor}, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 1, count)
+}
@@ -0,0 +1,194 @@
+package hypervisors
+
+import (
+	"github.com/mitchellh/mapstructure"
+	"github.com/rackspace/gophercloud"
+	"github.com/rackspace/gophercloud/pagination"
+)
+
+// Hypervisor is a compute host's hypervisor, with its capacity and usage.
+type Hypervisor struct {
+	// ID is the unique ID of the hypervisor. It's a UUID from microversion 2.53 on, and an integer
+	// before that.
+	ID string `mapstructure:"id"`
+
+	// HypervisorHostname is the host name of the hypervisor, as reported by the hypervisor itself.
+	HypervisorHostname string `mapstructure:"hypervisor_hostname"`
+
+	// State is "up" or "down", and Status "enabled" or "disabled".
+	State  string `mapstructure:"state"`
+	Status string `mapstructure:"status"`
+
+	// HypervisorType and HypervisorVersion identify the virtualization software, e.g. "QEMU".
+	HypervisorType    string `mapstructure:"hypervisor_type"`
+	HypervisorVersion int    `mapstructure:"hypervisor_version"`
+
+	// HostIP is the IP address of the hypervisor's host.
+	HostIP string `mapstructure:"host_ip"`
+
+	// VCPUs and VCPUsUsed are the number of virtual CPUs of the hypervisor, and those allocated to
+	// servers.
+	VCPUs     int `mapstructure:"vcpus"`
+	VCPUsUsed int `mapstructure:"vcpus_used"`
+
+	// MemoryMB, MemoryMBUsed and FreeRAMMB are the total, allocated and free memory, in MB.
+	MemoryMB     int `mapstructure:"memory_mb"`
+	MemoryMBUsed int `mapstructure:"memory_mb_used"`
+	FreeRAMMB    int `mapstructure:"free_ram_mb"`
+
+	// LocalGB, LocalGBUsed and FreeDiskGB are the total, allocated and free local disk, in GB.
+	// DiskAvailableLeast is the disk actually available once over-committed disks are accounted for.
+	LocalGB            int `mapstructure:"local_gb"`
+	LocalGBUsed        int `mapstructure:"local_gb_used"`
+	FreeDiskGB         int `mapstructure:"free_disk_gb"`
+	DiskAvailableLeast int `mapstructure:"disk_available_least"`
+
+	// RunningVMs is the number of servers on the hypervisor, and CurrentWorkload the number of
+	// tasks, such as builds and migrations, in progress on it.
+	RunningVMs      int `mapstructure:"running_vms"`
+	CurrentWorkload int `mapstructure:"current_workload"`
+
+	// Service is the compute service that manages the hypervisor. Its Host identifies the
+	// hypervisor's host when migrating servers.
+	Service Service `mapstructure:"service"`
+
+	// Servers are the servers that run on the hypervisor. They're only set by ListServers, and by
+	// List when ListOpts.WithServers is true.
+	Servers []Server `mapstructure:"servers"`
+}
+
+// Service is the compute service of a Hypervisor.
+type Service struct {
+	ID             string `mapstructure:"id"`
+	Host           string `mapstructure:"host"`
+	DisabledReason string `mapstructure:"disabled_reason"`
+}
+
+// Server is a server that runs on a Hypervisor.
+type Server struct {
+	UUID string `mapstructure:"uuid"`
+	Name string `mapstructure:"name"`
+}
+
+// Statistics are the capacity and usage of all hypervisors, summed up.
+type Statistics struct {
+	Count              int `mapstructure:"count"`
+	VCPUs              int `mapstructure:"vcpus"`
+	VCPUsUsed          int `mapstructure:"vcpus_used"`
+	MemoryMB           int `mapstructure:"memory_mb"`
+	MemoryMBUsed       int `mapstructure:"memory_mb_used"`
+	FreeRAMMB          int `mapstructure:"free_ram_mb"`
+	LocalGB            int `mapstructure:"local_gb"`
+	LocalGBUsed        int `mapstructure:"local_gb_used"`
+	FreeDiskGB         int `mapstructure:"free_disk_gb"`
+	DiskAvailableLeast int `mapstructure:"disk_available_least"`
+	RunningVMs         int `mapstructure:"running_vms"`
+	CurrentWorkload    int `mapstructure:"current_workload"`
+}
+
+// Uptime is the uptime of a hypervisor's host.
+type Uptime struct {
+	ID                 string `mapstructure:"id"`
+	HypervisorHostname string `mapstructure:"hypervisor_hostname"`
+	State              string `mapstructure:"state"`
+	Status             string `mapstructure:"status"`
+
+	// Uptime is the output of the uptime command on the host, e.g.
+	// " 08:32:11 up 93 days, 18:25, 12 users,  load average: 0.20, 0.12, 0.14".
+	Uptime string `mapstructure:"uptime"`
+}
+
+// HypervisorPage stores a single page of Hypervisors from a List or ListServers call.
+type HypervisorPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty determines whether or not a HypervisorPage is empty.
+func (page HypervisorPage) IsEmpty() (bool, error) {
+	hypervisors, err := ExtractHypervisors(page)
+	return len(hypervisors) == 0, err
+}
+
+// NextPageURL uses the response's embedded link reference to navigate to the next page of results.
+func (page HypervisorPage) NextPageURL() (string, error) {
+	var response struct {
+		Links []gophercloud.Link `mapstructure:"hypervisors_links"`
+	}
+
+	err := mapstructure.Decode(page.Body, &response)
+	if err != nil {
+		return "", err
+	}
+
+	return gophercloud.ExtractNextURL(response.Links)
+}
+
+// ExtractHypervisors interprets a page of results as a slice of Hypervisors.
+func ExtractHypervisors(page pagination.Page) ([]Hypervisor, error) {
+	var response struct {
+		Hypervisors []Hypervisor `mapstructure:"hypervisors"`
+	}
+
+	err := mapstructure.WeakDecode(page.(HypervisorPage).Body, &response)
+	return response.Hypervisors, err
+}
+
+// GetResult is the response from a Get operation. Call its Extract method to interpret it
+// as a Hypervisor.
+type GetResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a GetResult as a Hypervisor.
+func (r GetResult) Extract() (*Hypervisor, error) {
+	if r.Err != nil {
+		return nil, r.Err
+	}
+
+	var response struct {
+		Hypervisor *Hypervisor `mapstructure:"hypervisor"`
+	}
+
+	err := mapstructure.WeakDecode(r.Body, &response)
+	return response.Hypervisor, err
+}
+
+// StatisticsResult is the response from a GetStatistics operation. Call its Extract method to
+// interpret it as Statistics.
+type StatisticsResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a StatisticsResult as Statistics.
+func (r StatisticsResult) Extract() (*Statistics, error) {
+	if r.Err != nil {
+		return nil, r.Err
+	}
+
+	var response struct {
+		Statistics *Statistics `mapstructure:"hypervisor_statistics"`
+	}
+
+	err := mapstructure.WeakDecode(r.Body, &response)
+	return response.Statistics, err
+}
+
+// UptimeResult is the response from a GetUptime operation. Call its Extract method to interpret
+// it as an Uptime.
+type UptimeResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets an UptimeResult as an Uptime.
+func (r UptimeResult) Extract() (*Uptime, error) {
+	if r.Err != nil {
+		return nil, r.Err
+	}
+
+	var response struct {
+		Uptime *Uptime `mapstructure:"hypervisor"`
+	}
+
+	err := mapstructure.WeakDecode(r.Body, &response)
+	return response.Uptime, err
+}
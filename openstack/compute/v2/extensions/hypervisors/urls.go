@@ -0,0 +1,25 @@
+package hypervisors
+
+import "github.com/rackspace/gophercloud"
+
+const resourcePath = "os-hypervisors"
+
+func listURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(resourcePath, "detail")
+}
+
+func getURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL(resourcePath, id)
+}
+
+func statisticsURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(resourcePath, "statistics")
+}
+
+func uptimeURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL(resourcePath, id, "uptime")
+}
+
+func serversURL(c *gophercloud.ServiceClient, hostnamePattern string) string {
+	return c.ServiceURL(resourcePath, hostnamePattern, "servers")
+}
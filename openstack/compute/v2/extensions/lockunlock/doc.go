@@ -0,0 +1,12 @@
+/*
+Package lockunlock provides functionality to lock and unlock servers
+that have been provisioned by the OpenStack Compute service.
+
+A locked server can't be changed by users other than administrators and the
+user that locked it. Locking doesn't change the status of the server, so there
+is nothing for servers.WaitForStatus to wait for; the "locked" attribute of the
+server reflects the lock instead, on microversions that report it.
+
+	err := lockunlock.Lock(computeClient, serverID).ExtractErr()
+*/
+package lockunlock
@@ -0,0 +1,26 @@
+package lockunlock
+
+import (
+	"github.com/rackspace/gophercloud"
+	"github.com/rackspace/gophercloud/openstack/compute/v2/servers"
+)
+
+func actionURL(client *gophercloud.ServiceClient, id string) string {
+	return client.ServiceURL("servers", id, "action")
+}
+
+// Lock locks a Compute server. Its status is left as it was.
+func Lock(client *gophercloud.ServiceClient, id string) servers.ActionResult {
+	var res servers.ActionResult
+	reqBody := map[string]interface{}{"lock": nil}
+	_, res.Err = client.Post(actionURL(client, id), reqBody, nil, nil)
+	return res
+}
+
+// Unlock unlocks a locked Compute server. Its status is left as it was.
+func Unlock(client *gophercloud.ServiceClient, id string) servers.ActionResult {
+	var res servers.ActionResult
+	reqBody := map[string]interface{}{"unlock": nil}
+	_, res.Err = client.Post(actionURL(client, id), reqBody, nil, nil)
+	return res
+}
@@ -0,0 +1,16 @@
+/*
+Package pauseunpause provides functionality to pause and unpause servers
+that have been provisioned by the OpenStack Compute service.
+
+A paused server keeps its memory but stops running on its hypervisor. Once
+Pause succeeds the server's status becomes PAUSED, and once Unpause succeeds it
+becomes ACTIVE again; servers.WaitForStatus can wait for either, for example:
+
+	err := pauseunpause.Pause(computeClient, serverID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+	err = servers.WaitForStatus(computeClient, serverID, "PAUSED", 60)
+*/
+package pauseunpause
@@ -0,0 +1,26 @@
+package pauseunpause
+
+import (
+	"github.com/rackspace/gophercloud"
+	"github.com/rackspace/gophercloud/openstack/compute/v2/servers"
+)
+
+func actionURL(client *gophercloud.ServiceClient, id string) string {
+	return client.ServiceURL("servers", id, "action")
+}
+
+// Pause pauses a Compute server. Its status becomes PAUSED.
+func Pause(client *gophercloud.ServiceClient, id string) servers.ActionResult {
+	var res servers.ActionResult
+	reqBody := map[string]interface{}{"pause": nil}
+	_, res.Err = client.Post(actionURL(client, id), reqBody, nil, nil)
+	return res
+}
+
+// Unpause resumes a paused Compute server. Its status becomes ACTIVE.
+func Unpause(client *gophercloud.ServiceClient, id string) servers.ActionResult {
+	var res servers.ActionResult
+	reqBody := map[string]interface{}{"unpause": nil}
+	_, res.Err = client.Post(actionURL(client, id), reqBody, nil, nil)
+	return res
+}
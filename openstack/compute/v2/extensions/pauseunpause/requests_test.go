@@ -0,0 +1,30 @@
+package pauseunpause
+
+import (
+	"testing"
+
+	th "github.com/rackspace/gophercloud/testhelper"
+	"github.com/rackspace/gophercloud/testhelper/client"
+)
+
+const serverID = "{serverId}"
+
+func TestPause(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	mockPauseServerResponse(t, serverID)
+
+	err := Pause(client.ServiceClient(), serverID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestUnpause(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	mockUnpauseServerResponse(t, serverID)
+
+	err := Unpause(client.ServiceClient(), serverID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
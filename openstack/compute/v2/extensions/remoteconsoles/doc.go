@@ -0,0 +1,16 @@
+/*
+Package remoteconsoles provides the ability to create remote consoles for
+servers of the OpenStack Compute service, such as the noVNC console that a
+browser can embed. It requires microversion 2.6 or later:
+
+	console, err := remoteconsoles.Create(computeClient.WithMicroversion("2.6"), serverID, remoteconsoles.CreateOpts{
+		Protocol: remoteconsoles.ConsoleProtocolVNC,
+		Type:     remoteconsoles.ConsoleTypeNoVNC,
+	}).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(console.URL)
+*/
+package remoteconsoles
@@ -0,0 +1,88 @@
+package remoteconsoles
+
+import (
+	"errors"
+
+	"github.com/rackspace/gophercloud"
+)
+
+// ConsoleProtocol is the protocol of a remote console.
+type ConsoleProtocol string
+
+// ConsoleType is the type of a remote console, which determines how its URL is used. Each type
+// belongs to one ConsoleProtocol.
+type ConsoleType string
+
+const (
+	// ConsoleProtocolVNC is the protocol of the ConsoleTypeNoVNC and ConsoleTypeXVPVNC consoles.
+	ConsoleProtocolVNC ConsoleProtocol = "vnc"
+
+	// ConsoleProtocolSPICE is the protocol of the ConsoleTypeSPICEHTML5 console.
+	ConsoleProtocolSPICE ConsoleProtocol = "spice"
+
+	// ConsoleProtocolSerial is the protocol of the ConsoleTypeSerial console.
+	ConsoleProtocolSerial ConsoleProtocol = "serial"
+
+	// ConsoleProtocolRDP is the protocol of the ConsoleTypeRDPHTML5 console.
+	ConsoleProtocolRDP ConsoleProtocol = "rdp"
+
+	// ConsoleTypeNoVNC is a VNC console for the noVNC web client.
+	ConsoleTypeNoVNC ConsoleType = "novnc"
+
+	// ConsoleTypeXVPVNC is a VNC console for the XVP VNC Java client.
+	ConsoleTypeXVPVNC ConsoleType = "xvpvnc"
+
+	// ConsoleTypeSPICEHTML5 is a SPICE console for the spice-html5 web client.
+	ConsoleTypeSPICEHTML5 ConsoleType = "spice-html5"
+
+	// ConsoleTypeSerial is a websocket URL to the server's serial port.
+	ConsoleTypeSerial ConsoleType = "serial"
+
+	// ConsoleTypeRDPHTML5 is an RDP console for web clients, on Hyper-V hosts.
+	ConsoleTypeRDPHTML5 ConsoleType = "rdp-html5"
+)
+
+// CreateOptsBuilder allows extensions to add additional parameters to the Create request.
+type CreateOptsBuilder interface {
+	ToRemoteConsoleCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts specifies the remote console to create.
+type CreateOpts struct {
+	// Protocol and Type are required, and Type must belong to Protocol.
+	Protocol ConsoleProtocol
+	Type     ConsoleType
+}
+
+// ToRemoteConsoleCreateMap constructs a request body from CreateOpts.
+func (opts CreateOpts) ToRemoteConsoleCreateMap() (map[string]interface{}, error) {
+	if opts.Protocol == "" {
+		return nil, errors.New("Missing field required for remote console creation: Protocol")
+	}
+	if opts.Type == "" {
+		return nil, errors.New("Missing field required for remote console creation: Type")
+	}
+
+	console := map[string]interface{}{
+		"protocol": opts.Protocol,
+		"type":     opts.Type,
+	}
+	return map[string]interface{}{"remote_console": console}, nil
+}
+
+// Create requests a remote console for a server. The console's URL carries a token that expires
+// after a while, so a new console should be created each time one is opened.
+func Create(client *gophercloud.ServiceClient, serverID string, opts CreateOptsBuilder) CreateResult {
+	var res CreateResult
+
+	reqBody, err := opts.ToRemoteConsoleCreateMap()
+	if err != nil {
+		res.Err = err
+		return res
+	}
+
+	_, res.Err = client.Post(createURL(client, serverID), reqBody, &res.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return res
+}
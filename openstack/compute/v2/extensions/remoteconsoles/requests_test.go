@@ -0,0 +1,30 @@
+package remoteconsoles
+
+import (
+	"testing"
+
+	th "github.com/rackspace/gophercloud/testhelper"
+	"github.com/rackspace/gophercloud/testhelper/client"
+)
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateSuccessfully(t)
+
+	actual, err := Create(client.ServiceClient(), "b16ba811-199d-4ffd-8839-ba96c1185a67", CreateOpts{
+		Protocol: ConsoleProtocolVNC,
+		Type:     ConsoleTypeNoVNC,
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &CreatedRemoteConsole, actual)
+}
+
+func TestCreateRequiresType(t *testing.T) {
+	res := Create(client.ServiceClient(), "b16ba811-199d-4ffd-8839-ba96c1185a67", CreateOpts{
+		Protocol: ConsoleProtocolSerial,
+	})
+	if res.Err == nil {
+		t.Fatalf("Expected an error for a remote console without a type")
+	}
+}
@@ -0,0 +1,36 @@
+package remoteconsoles
+
+import (
+	"github.com/mitchellh/mapstructure"
+	"github.com/rackspace/gophercloud"
+)
+
+// RemoteConsole is a remote console of a server.
+type RemoteConsole struct {
+	// Protocol and Type are those that the console was created with.
+	Protocol ConsoleProtocol `mapstructure:"protocol"`
+	Type     ConsoleType     `mapstructure:"type"`
+
+	// URL is where the console can be opened.
+	URL string `mapstructure:"url"`
+}
+
+// CreateResult is the response from a Create operation. Call its Extract method to interpret it
+// as a RemoteConsole.
+type CreateResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a CreateResult as a RemoteConsole.
+func (r CreateResult) Extract() (*RemoteConsole, error) {
+	if r.Err != nil {
+		return nil, r.Err
+	}
+
+	var response struct {
+		RemoteConsole *RemoteConsole `mapstructure:"remote_console"`
+	}
+
+	err := mapstructure.Decode(r.Body, &response)
+	return response.RemoteConsole, err
+}
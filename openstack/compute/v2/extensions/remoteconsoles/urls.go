@@ -0,0 +1,7 @@
+package remoteconsoles
+
+import "github.com/rackspace/gophercloud"
+
+func createURL(c *gophercloud.ServiceClient, serverID string) string {
+	return c.ServiceURL("servers", serverID, "remote-consoles")
+}
@@ -0,0 +1,16 @@
+/*
+Package services provides information about the services of the OpenStack
+Compute service, such as nova-compute and nova-scheduler, and lets
+administrators take compute hosts out of scheduling.
+
+Enable, Disable and ForceDown identify a service by its host and binary, and
+are removed in microversion 2.53. From that microversion on, Update changes a
+service identified by its UUID instead:
+
+	service, err := services.Disable(computeClient, services.DisableOpts{
+		Host:           "compute-01",
+		Binary:         "nova-compute",
+		DisabledReason: "hardware maintenance",
+	}).Extract()
+*/
+package services
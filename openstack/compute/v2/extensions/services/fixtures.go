@@ -0,0 +1,182 @@
+// +build fixtures
+
+package services
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/rackspace/gophercloud/testhelper"
+	"github.com/rackspace/gophercloud/testhelper/client"
+)
+
+// ListOutput is a sample response to a List call.
+const ListOutput = `
+{
+    "services": [
+        {
+            "id": 1,
+            "binary": "nova-scheduler",
+            "host": "controller-01",
+            "zone": "internal",
+            "status": "enabled",
+            "state": "up",
+            "disabled_reason": null,
+            "forced_down": false,
+            "updated_at": "2016-05-12T12:20:13.000000"
+        },
+        {
+            "id": 6,
+            "binary": "nova-compute",
+            "host": "compute-01",
+            "zone": "nova",
+            "status": "disabled",
+            "state": "up",
+            "disabled_reason": "hardware maintenance",
+            "forced_down": false,
+            "updated_at": "2016-05-12T12:20:10.000000"
+        }
+    ]
+}
+`
+
+// DisableOutput is a sample response to a Disable call.
+const DisableOutput = `
+{
+    "service": {
+        "binary": "nova-compute",
+        "host": "compute-01",
+        "status": "disabled",
+        "disabled_reason": "hardware maintenance"
+    }
+}
+`
+
+// EnableOutput is a sample response to an Enable call.
+const EnableOutput = `
+{
+    "service": {
+        "binary": "nova-compute",
+        "host": "compute-01",
+        "status": "enabled"
+    }
+}
+`
+
+// ForceDownOutput is a sample response to a ForceDown call.
+const ForceDownOutput = `
+{
+    "service": {
+        "binary": "nova-compute",
+        "host": "compute-01",
+        "forced_down": true
+    }
+}
+`
+
+// FirstService is the first result in ListOutput.
+var FirstService = Service{
+	ID:        "1",
+	Binary:    "nova-scheduler",
+	Host:      "controller-01",
+	Zone:      "internal",
+	Status:    "enabled",
+	State:     "up",
+	UpdatedAt: "2016-05-12T12:20:13.000000",
+}
+
+// SecondService is the second result in ListOutput.
+var SecondService = Service{
+	ID:             "6",
+	Binary:         "nova-compute",
+	Host:           "compute-01",
+	Zone:           "nova",
+	Status:         "disabled",
+	State:          "up",
+	DisabledReason: "hardware maintenance",
+	UpdatedAt:      "2016-05-12T12:20:10.000000",
+}
+
+// ExpectedServiceSlice is the slice of results that should be parsed from ListOutput.
+var ExpectedServiceSlice = []Service{FirstService, SecondService}
+
+// HandleListSuccessfully configures the test server to respond to a List request.
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-services", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, ListOutput)
+	})
+}
+
+// HandleEnableSuccessfully configures the test server to respond to an Enable request.
+func HandleEnableSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-services/enable", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, `{"host": "compute-01", "binary": "nova-compute"}`)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, EnableOutput)
+	})
+}
+
+// HandleDisableSuccessfully configures the test server to respond to a Disable request with a
+// reason.
+func HandleDisableSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-services/disable-log-reason", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, `
+{
+    "host": "compute-01",
+    "binary": "nova-compute",
+    "disabled_reason": "hardware maintenance"
+}
+`)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, DisableOutput)
+	})
+}
+
+// HandleForceDownSuccessfully configures the test server to respond to a ForceDown request.
+func HandleForceDownSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-services/force-down", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, `{"host": "compute-01", "binary": "nova-compute", "forced_down": true}`)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, ForceDownOutput)
+	})
+}
+
+// HandleUpdateSuccessfully configures the test server to respond to an Update request.
+func HandleUpdateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-services/fa5d4e5d-d9e3-4a7d-a1ab-3b7b1ea3d7c2", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, `{"status": "disabled", "disabled_reason": "hardware maintenance"}`)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `
+{
+    "service": {
+        "id": "fa5d4e5d-d9e3-4a7d-a1ab-3b7b1ea3d7c2",
+        "binary": "nova-compute",
+        "host": "compute-01",
+        "zone": "nova",
+        "status": "disabled",
+        "state": "up",
+        "disabled_reason": "hardware maintenance",
+        "forced_down": false,
+        "updated_at": "2016-05-12T12:20:10.000000"
+    }
+}
+`)
+	})
+}
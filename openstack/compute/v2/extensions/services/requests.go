@@ -0,0 +1,230 @@
+package services
+
+import (
+	"errors"
+
+	"github.com/rackspace/gophercloud"
+	"github.com/rackspace/gophercloud/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the List request.
+type ListOptsBuilder interface {
+	ToServiceListQuery() (string, error)
+}
+
+// ListOpts helps control the results returned by the List function.
+type ListOpts struct {
+	// Binary, if provided, only lists services of that binary, e.g. "nova-compute".
+	Binary string `q:"binary"`
+
+	// Host, if provided, only lists the services of that host.
+	Host string `q:"host"`
+}
+
+// ToServiceListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToServiceListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), nil
+}
+
+// List returns a Pager that allows you to iterate over the compute services.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(client)
+	if opts != nil {
+		query, err := opts.ToServiceListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return ServicePage{pagination.SinglePageBase(r)}
+	})
+}
+
+// HostOpts identifies a service by the host it runs on and its binary.
+type HostOpts struct {
+	// Host and Binary are required.
+	Host   string
+	Binary string
+}
+
+func (opts HostOpts) toMap() (map[string]interface{}, error) {
+	if opts.Host == "" {
+		return nil, errors.New("Missing field required for service update: Host")
+	}
+	if opts.Binary == "" {
+		return nil, errors.New("Missing field required for service update: Binary")
+	}
+	return map[string]interface{}{"host": opts.Host, "binary": opts.Binary}, nil
+}
+
+// EnableOptsBuilder allows extensions to add additional parameters to the Enable request.
+type EnableOptsBuilder interface {
+	ToServiceEnableMap() (map[string]interface{}, error)
+}
+
+// ToServiceEnableMap constructs a request body from HostOpts.
+func (opts HostOpts) ToServiceEnableMap() (map[string]interface{}, error) {
+	return opts.toMap()
+}
+
+// Enable lets the scheduler place servers on a service's host again.
+func Enable(client *gophercloud.ServiceClient, opts EnableOptsBuilder) UpdateResult {
+	var res UpdateResult
+
+	reqBody, err := opts.ToServiceEnableMap()
+	if err != nil {
+		res.Err = err
+		return res
+	}
+
+	_, res.Err = client.Put(enableURL(client), reqBody, &res.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return res
+}
+
+// DisableOptsBuilder allows extensions to add additional parameters to the Disable request.
+type DisableOptsBuilder interface {
+	ToServiceDisableMap() (map[string]interface{}, error)
+}
+
+// DisableOpts identifies the service to disable, and why.
+type DisableOpts struct {
+	// Host and Binary are required.
+	Host   string
+	Binary string
+
+	// DisabledReason: optional, the reason the service is disabled.
+	DisabledReason string
+}
+
+// ToServiceDisableMap constructs a request body from DisableOpts.
+func (opts DisableOpts) ToServiceDisableMap() (map[string]interface{}, error) {
+	service, err := HostOpts{Host: opts.Host, Binary: opts.Binary}.toMap()
+	if err != nil {
+		return nil, err
+	}
+	if opts.DisabledReason != "" {
+		service["disabled_reason"] = opts.DisabledReason
+	}
+	return service, nil
+}
+
+// Disable stops the scheduler from placing servers on a service's host. Servers already on the
+// host keep running.
+func Disable(client *gophercloud.ServiceClient, opts DisableOptsBuilder) UpdateResult {
+	var res UpdateResult
+
+	reqBody, err := opts.ToServiceDisableMap()
+	if err != nil {
+		res.Err = err
+		return res
+	}
+
+	url := disableURL(client)
+	if _, ok := reqBody["disabled_reason"]; ok {
+		url = disableLogReasonURL(client)
+	}
+
+	_, res.Err = client.Put(url, reqBody, &res.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return res
+}
+
+// ForceDownOptsBuilder allows extensions to add additional parameters to the ForceDown request.
+type ForceDownOptsBuilder interface {
+	ToServiceForceDownMap() (map[string]interface{}, error)
+}
+
+// ForceDownOpts identifies the service to mark as down or up.
+type ForceDownOpts struct {
+	// Host and Binary are required.
+	Host   string
+	Binary string
+
+	// ForcedDown marks the service as down, which allows its servers to be evacuated without
+	// waiting for the service to miss its heartbeats, or clears the mark when false.
+	ForcedDown bool
+}
+
+// ToServiceForceDownMap constructs a request body from ForceDownOpts.
+func (opts ForceDownOpts) ToServiceForceDownMap() (map[string]interface{}, error) {
+	service, err := HostOpts{Host: opts.Host, Binary: opts.Binary}.toMap()
+	if err != nil {
+		return nil, err
+	}
+	service["forced_down"] = opts.ForcedDown
+	return service, nil
+}
+
+// ForceDown marks a service as down, or clears the mark. It requires microversion 2.11 or later.
+func ForceDown(client *gophercloud.ServiceClient, opts ForceDownOptsBuilder) UpdateResult {
+	var res UpdateResult
+
+	reqBody, err := opts.ToServiceForceDownMap()
+	if err != nil {
+		res.Err = err
+		return res
+	}
+
+	_, res.Err = client.Put(forceDownURL(client), reqBody, &res.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return res
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the Update request.
+type UpdateOptsBuilder interface {
+	ToServiceUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts specifies the changes to make to a service.
+type UpdateOpts struct {
+	// Status: optional, "enabled" or "disabled".
+	Status string
+
+	// DisabledReason: optional, the reason the service is disabled. It requires Status to be
+	// "disabled".
+	DisabledReason string
+
+	// ForcedDown: optional, marks the service as down, or clears the mark.
+	ForcedDown *bool
+}
+
+// ToServiceUpdateMap constructs a request body from UpdateOpts.
+func (opts UpdateOpts) ToServiceUpdateMap() (map[string]interface{}, error) {
+	service := make(map[string]interface{})
+	if opts.Status != "" {
+		service["status"] = opts.Status
+	}
+	if opts.DisabledReason != "" {
+		service["disabled_reason"] = opts.DisabledReason
+	}
+	if opts.ForcedDown != nil {
+		service["forced_down"] = *opts.ForcedDown
+	}
+	return service, nil
+}
+
+// Update changes a service identified by its UUID. It requires microversion 2.53 or later.
+func Update(client *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) UpdateResult {
+	var res UpdateResult
+
+	reqBody, err := opts.ToServiceUpdateMap()
+	if err != nil {
+		res.Err = err
+		return res
+	}
+
+	_, res.Err = client.Put(updateURL(client, id), reqBody, &res.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return res
+}
@@ -0,0 +1,87 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/rackspace/gophercloud/pagination"
+	th "github.com/rackspace/gophercloud/testhelper"
+	"github.com/rackspace/gophercloud/testhelper/client"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	count := 0
+	err := List(client.ServiceClient(), nil).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		actual, err := ExtractServices(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, ExpectedServiceSlice, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 1, count)
+}
+
+func TestEnable(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleEnableSuccessfully(t)
+
+	actual, err := Enable(client.ServiceClient(), HostOpts{Host: "compute-01", Binary: "nova-compute"}).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "enabled", actual.Status)
+}
+
+func TestDisable(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDisableSuccessfully(t)
+
+	actual, err := Disable(client.ServiceClient(), DisableOpts{
+		Host:           "compute-01",
+		Binary:         "nova-compute",
+		DisabledReason: "hardware maintenance",
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "disabled", actual.Status)
+	th.CheckEquals(t, "hardware maintenance", actual.DisabledReason)
+}
+
+func TestDisableRequiresHost(t *testing.T) {
+	res := Disable(client.ServiceClient(), DisableOpts{Binary: "nova-compute"})
+	if res.Err == nil {
+		t.Fatalf("Expected an error for a service without a host")
+	}
+}
+
+func TestForceDown(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleForceDownSuccessfully(t)
+
+	actual, err := ForceDown(client.ServiceClient(), ForceDownOpts{
+		Host:       "compute-01",
+		Binary:     "nova-compute",
+		ForcedDown: true,
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, true, actual.ForcedDown)
+}
+
+func TestUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdateSuccessfully(t)
+
+	actual, err := Update(client.ServiceClient(), "fa5d4e5d-d9e3-4a7d-a1ab-3b7b1ea3d7c2", UpdateOpts{
+		Status:         "disabled",
+		DisabledReason: "hardware maintenance",
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "fa5d4e5d-d9e3-4a7d-a1ab-3b7b1ea3d7c2", actual.ID)
+	th.CheckEquals(t, "disabled", actual.Status)
+}
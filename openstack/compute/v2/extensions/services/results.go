@@ -0,0 +1,76 @@
+package services
+
+import (
+	"github.com/mitchellh/mapstructure"
+	"github.com/rackspace/gophercloud"
+	"github.com/rackspace/gophercloud/pagination"
+)
+
+// Service is a compute service running on a host, such as nova-compute.
+type Service struct {
+	// ID is the unique ID of the service. It's a UUID from microversion 2.53 on, and an integer
+	// before that.
+	ID string `mapstructure:"id"`
+
+	// Binary is the name of the service's binary, e.g. "nova-compute".
+	Binary string `mapstructure:"binary"`
+
+	// Host is the host the service runs on, and Zone the availability zone of that host.
+	Host string `mapstructure:"host"`
+	Zone string `mapstructure:"zone"`
+
+	// Status is "enabled" or "disabled", and State "up" or "down".
+	Status string `mapstructure:"status"`
+	State  string `mapstructure:"state"`
+
+	// DisabledReason is the reason the service was disabled, if one was given.
+	DisabledReason string `mapstructure:"disabled_reason"`
+
+	// ForcedDown is true when the service was marked as down with ForceDown.
+	ForcedDown bool `mapstructure:"forced_down"`
+
+	// UpdatedAt is when the service last reported in.
+	UpdatedAt string `mapstructure:"updated_at"`
+}
+
+// ServicePage stores a single, only page of Services from a List call.
+type ServicePage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty determines whether or not a ServicePage is empty.
+func (page ServicePage) IsEmpty() (bool, error) {
+	services, err := ExtractServices(page)
+	return len(services) == 0, err
+}
+
+// ExtractServices interprets a page of results as a slice of Services.
+func ExtractServices(page pagination.Page) ([]Service, error) {
+	var response struct {
+		Services []Service `mapstructure:"services"`
+	}
+
+	err := mapstructure.WeakDecode(page.(ServicePage).Body, &response)
+	return response.Services, err
+}
+
+// UpdateResult is the response from an Enable, Disable, ForceDown or Update operation. Call its
+// Extract method to interpret it as a Service.
+type UpdateResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets an UpdateResult as a Service. Before microversion 2.53, only the fields that
+// identify the service and those that changed are set.
+func (r UpdateResult) Extract() (*Service, error) {
+	if r.Err != nil {
+		return nil, r.Err
+	}
+
+	var response struct {
+		Service *Service `mapstructure:"service"`
+	}
+
+	err := mapstructure.WeakDecode(r.Body, &response)
+	return response.Service, err
+}
@@ -0,0 +1,29 @@
+package services
+
+import "github.com/rackspace/gophercloud"
+
+const resourcePath = "os-services"
+
+func listURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(resourcePath)
+}
+
+func enableURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(resourcePath, "enable")
+}
+
+func disableURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(resourcePath, "disable")
+}
+
+func disableLogReasonURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(resourcePath, "disable-log-reason")
+}
+
+func forceDownURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(resourcePath, "force-down")
+}
+
+func updateURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL(resourcePath, id)
+}
@@ -0,0 +1,20 @@
+/*
+Package shelveunshelve provides functionality to shelve and unshelve servers
+that have been provisioned by the OpenStack Compute service.
+
+A shelved server is shut down and snapshotted. Once Shelve succeeds the
+server's status becomes SHELVED, or SHELVED_OFFLOADED straight away when the
+cloud's shelved_offload_time is 0. ShelveOffload then releases the server's
+resources on its hypervisor, after which the status is SHELVED_OFFLOADED.
+Unshelve boots the server again, on a host chosen by the scheduler, and its
+status becomes ACTIVE. servers.WaitForStatus can wait for each of these, for
+example:
+
+	err := shelveunshelve.ShelveOffload(computeClient, serverID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+	err = servers.WaitForStatus(computeClient, serverID, "SHELVED_OFFLOADED", 120)
+*/
+package shelveunshelve
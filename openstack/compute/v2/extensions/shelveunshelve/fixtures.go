@@ -0,0 +1,36 @@
+// +build fixtures
+
+package shelveunshelve
+
+import (
+	"net/http"
+	"testing"
+
+	th "github.com/rackspace/gophercloud/testhelper"
+	"github.com/rackspace/gophercloud/testhelper/client"
+)
+
+func mockActionResponse(t *testing.T, id, body string) {
+	th.Mux.HandleFunc("/servers/"+id+"/action", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, body)
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
+
+func mockShelveServerResponse(t *testing.T, id string) {
+	mockActionResponse(t, id, `{"shelve": null}`)
+}
+
+func mockShelveOffloadServerResponse(t *testing.T, id string) {
+	mockActionResponse(t, id, `{"shelveOffload": null}`)
+}
+
+func mockUnshelveServerResponse(t *testing.T, id string) {
+	mockActionResponse(t, id, `{"unshelve": null}`)
+}
+
+func mockUnshelveServerToZoneResponse(t *testing.T, id string) {
+	mockActionResponse(t, id, `{"unshelve": {"availability_zone": "nova"}}`)
+}
@@ -0,0 +1,64 @@
+package shelveunshelve
+
+import (
+	"github.com/rackspace/gophercloud"
+	"github.com/rackspace/gophercloud/openstack/compute/v2/servers"
+)
+
+func actionURL(client *gophercloud.ServiceClient, id string) string {
+	return client.ServiceURL("servers", id, "action")
+}
+
+// Shelve shuts down and snapshots a Compute server. Its status becomes SHELVED, or
+// SHELVED_OFFLOADED if the cloud offloads shelved servers immediately.
+func Shelve(client *gophercloud.ServiceClient, id string) servers.ActionResult {
+	var res servers.ActionResult
+	reqBody := map[string]interface{}{"shelve": nil}
+	_, res.Err = client.Post(actionURL(client, id), reqBody, nil, nil)
+	return res
+}
+
+// ShelveOffload releases the hypervisor resources of a shelved Compute server. Its status becomes
+// SHELVED_OFFLOADED.
+func ShelveOffload(client *gophercloud.ServiceClient, id string) servers.ActionResult {
+	var res servers.ActionResult
+	reqBody := map[string]interface{}{"shelveOffload": nil}
+	_, res.Err = client.Post(actionURL(client, id), reqBody, nil, nil)
+	return res
+}
+
+// UnshelveOptsBuilder allows extensions to add additional parameters to the Unshelve request.
+type UnshelveOptsBuilder interface {
+	ToUnshelveMap() (map[string]interface{}, error)
+}
+
+// UnshelveOpts specifies how a shelved server is restored.
+type UnshelveOpts struct {
+	// AvailabilityZone: optional, the availability zone to unshelve an offloaded server into.
+	// It requires microversion 2.77 or later.
+	AvailabilityZone string
+}
+
+// ToUnshelveMap assembles a request body based on the contents of an UnshelveOpts.
+func (opts UnshelveOpts) ToUnshelveMap() (map[string]interface{}, error) {
+	if opts.AvailabilityZone == "" {
+		return map[string]interface{}{"unshelve": nil}, nil
+	}
+
+	unshelve := map[string]interface{}{"availability_zone": opts.AvailabilityZone}
+	return map[string]interface{}{"unshelve": unshelve}, nil
+}
+
+// Unshelve restores a shelved Compute server. Its status becomes ACTIVE.
+func Unshelve(client *gophercloud.ServiceClient, id string, opts UnshelveOptsBuilder) servers.ActionResult {
+	var res servers.ActionResult
+
+	reqBody, err := opts.ToUnshelveMap()
+	if err != nil {
+		res.Err = err
+		return res
+	}
+
+	_, res.Err = client.Post(actionURL(client, id), reqBody, nil, nil)
+	return res
+}
@@ -0,0 +1,50 @@
+package shelveunshelve
+
+import (
+	"testing"
+
+	th "github.com/rackspace/gophercloud/testhelper"
+	"github.com/rackspace/gophercloud/testhelper/client"
+)
+
+const serverID = "{serverId}"
+
+func TestShelve(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	mockShelveServerResponse(t, serverID)
+
+	err := Shelve(client.ServiceClient(), serverID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestShelveOffload(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	mockShelveOffloadServerResponse(t, serverID)
+
+	err := ShelveOffload(client.ServiceClient(), serverID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestUnshelve(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	mockUnshelveServerResponse(t, serverID)
+
+	err := Unshelve(client.ServiceClient(), serverID, UnshelveOpts{}).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestUnshelveToAvailabilityZone(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	mockUnshelveServerToZoneResponse(t, serverID)
+
+	err := Unshelve(client.ServiceClient(), serverID, UnshelveOpts{AvailabilityZone: "nova"}).ExtractErr()
+	th.AssertNoErr(t, err)
+}
@@ -0,0 +1,17 @@
+/*
+Package suspendresume provides functionality to suspend and resume servers
+that have been provisioned by the OpenStack Compute service.
+
+A suspended server has its memory written to disk and releases its CPU and
+memory on the hypervisor. Once Suspend succeeds the server's status becomes
+SUSPENDED, and once Resume succeeds it becomes ACTIVE again; servers.WaitForStatus
+can wait for either, for example:
+
+	err := suspendresume.Suspend(computeClient, serverID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+	err = servers.WaitForStatus(computeClient, serverID, "SUSPENDED", 60)
+*/
+package suspendresume
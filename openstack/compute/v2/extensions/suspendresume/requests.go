@@ -0,0 +1,26 @@
+package suspendresume
+
+import (
+	"github.com/rackspace/gophercloud"
+	"github.com/rackspace/gophercloud/openstack/compute/v2/servers"
+)
+
+func actionURL(client *gophercloud.ServiceClient, id string) string {
+	return client.ServiceURL("servers", id, "action")
+}
+
+// Suspend suspends a Compute server. Its status becomes SUSPENDED.
+func Suspend(client *gophercloud.ServiceClient, id string) servers.ActionResult {
+	var res servers.ActionResult
+	reqBody := map[string]interface{}{"suspend": nil}
+	_, res.Err = client.Post(actionURL(client, id), reqBody, nil, nil)
+	return res
+}
+
+// Resume resumes a suspended Compute server. Its status becomes ACTIVE.
+func Resume(client *gophercloud.ServiceClient, id string) servers.ActionResult {
+	var res servers.ActionResult
+	reqBody := map[string]interface{}{"resume": nil}
+	_, res.Err = client.Post(actionURL(client, id), reqBody, nil, nil)
+	return res
+}
@@ -0,0 +1,30 @@
+package suspendresume
+
+import (
+	"testing"
+
+	th "github.com/rackspace/gophercloud/testhelper"
+	"github.com/rackspace/gophercloud/testhelper/client"
+)
+
+const serverID = "{serverId}"
+
+func TestSuspend(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	mockSuspendServerResponse(t, serverID)
+
+	err := Suspend(client.ServiceClient(), serverID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestResume(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	mockResumeServerResponse(t, serverID)
+
+	err := Resume(client.ServiceClient(), serverID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
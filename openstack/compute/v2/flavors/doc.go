@@ -4,4 +4,9 @@
 // A flavor is an available hardware configuration for a server. Each flavor
 // has a unique combination of disk space, memory capacity and priority for CPU
 // time.
+//
+// Administrators can also create and delete flavors, restrict private flavors
+// to a list of projects with AddAccess and RemoveAccess, and attach extra specs
+// to a flavor, which the scheduler uses for CPU pinning, NUMA placement and
+// host aggregate matching.
 package flavors
@@ -0,0 +1,211 @@
+// +build fixtures
+
+package flavors
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/rackspace/gophercloud/testhelper"
+	"github.com/rackspace/gophercloud/testhelper/client"
+)
+
+// CreateOutput contains the canned body of a flavors.Create response.
+const CreateOutput = `
+{
+	"flavor": {
+		"id": "10",
+		"name": "m1.pinned",
+		"disk": 20,
+		"ram": 4096,
+		"vcpus": 2,
+		"swap": 512,
+		"rxtx_factor": 2.0,
+		"OS-FLV-EXT-DATA:ephemeral": 10,
+		"os-flavor-access:is_public": false
+	}
+}
+`
+
+// AccessOutput contains the canned body of a flavors.ListAccesses, flavors.AddAccess or
+// flavors.RemoveAccess response.
+const AccessOutput = `
+{
+	"flavor_access": [
+		{
+			"flavor_id": "10",
+			"tenant_id": "2f6f7e28fe6e4c7aa9b6ab1e8d3cd2b9"
+		}
+	]
+}
+`
+
+// ExtraSpecsOutput contains the canned body of a flavors.ListExtraSpecs or flavors.CreateExtraSpecs
+// response.
+const ExtraSpecsOutput = `
+{
+	"extra_specs": {
+		"hw:cpu_policy": "dedicated",
+		"hw:numa_nodes": "1"
+	}
+}
+`
+
+// ExtraSpecOutput contains the canned body of a flavors.GetExtraSpec or flavors.UpdateExtraSpec
+// response.
+const ExtraSpecOutput = `
+{
+	"hw:cpu_policy": "dedicated"
+}
+`
+
+// CreatedFlavor is the Flavor described by CreateOutput.
+var CreatedFlavor = Flavor{
+	ID:         "10",
+	Name:       "m1.pinned",
+	Disk:       20,
+	RAM:        4096,
+	VCPUs:      2,
+	Swap:       512,
+	RxTxFactor: 2.0,
+	Ephemeral:  10,
+	IsPublic:   false,
+}
+
+// ExpectedAccesses is the slice of FlavorAccess described by AccessOutput.
+var ExpectedAccesses = []FlavorAccess{
+	{FlavorID: "10", TenantID: "2f6f7e28fe6e4c7aa9b6ab1e8d3cd2b9"},
+}
+
+// ExpectedExtraSpecs is the map of extra specs described by ExtraSpecsOutput.
+var ExpectedExtraSpecs = map[string]string{
+	"hw:cpu_policy": "dedicated",
+	"hw:numa_nodes": "1",
+}
+
+// ExpectedExtraSpec is the map of extra specs described by ExtraSpecOutput.
+var ExpectedExtraSpec = map[string]string{
+	"hw:cpu_policy": "dedicated",
+}
+
+// HandleFlavorCreationSuccessfully sets up the test server to respond to a flavor Create request.
+func HandleFlavorCreationSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/flavors", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, `{
+			"flavor": {
+				"id": "10",
+				"name": "m1.pinned",
+				"disk": 20,
+				"ram": 4096,
+				"vcpus": 2,
+				"swap": 512,
+				"rxtx_factor": 2.0,
+				"OS-FLV-EXT-DATA:ephemeral": 10,
+				"os-flavor-access:is_public": false
+			}
+		}`)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, CreateOutput)
+	})
+}
+
+// HandleFlavorDeletionSuccessfully sets up the test server to respond to a flavor Delete request.
+func HandleFlavorDeletionSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/flavors/10", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
+
+// HandleAccessListSuccessfully sets up the test server to respond to a flavor ListAccesses request.
+func HandleAccessListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/flavors/10/os-flavor-access", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, AccessOutput)
+	})
+}
+
+// HandleAccessActionSuccessfully sets up the test server to respond to a flavor AddAccess or
+// RemoveAccess request with the given action name.
+func HandleAccessActionSuccessfully(t *testing.T, action string) {
+	th.Mux.HandleFunc("/flavors/10/action", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, fmt.Sprintf(`{
+			"%s": { "tenant": "2f6f7e28fe6e4c7aa9b6ab1e8d3cd2b9" }
+		}`, action))
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, AccessOutput)
+	})
+}
+
+// HandleExtraSpecsListSuccessfully sets up the test server to respond to a flavor ListExtraSpecs
+// request.
+func HandleExtraSpecsListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/flavors/10/os-extra_specs", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, ExtraSpecsOutput)
+	})
+}
+
+// HandleExtraSpecGetSuccessfully sets up the test server to respond to a flavor GetExtraSpec
+// request.
+func HandleExtraSpecGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/flavors/10/os-extra_specs/hw:cpu_policy", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, ExtraSpecOutput)
+	})
+}
+
+// HandleExtraSpecsCreationSuccessfully sets up the test server to respond to a flavor
+// CreateExtraSpecs request.
+func HandleExtraSpecsCreationSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/flavors/10/os-extra_specs", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, ExtraSpecsOutput)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, ExtraSpecsOutput)
+	})
+}
+
+// HandleExtraSpecUpdateSuccessfully sets up the test server to respond to a flavor UpdateExtraSpec
+// request.
+func HandleExtraSpecUpdateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/flavors/10/os-extra_specs/hw:cpu_policy", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, ExtraSpecOutput)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, ExtraSpecOutput)
+	})
+}
+
+// HandleExtraSpecDeletionSuccessfully sets up the test server to respond to a flavor
+// DeleteExtraSpec request.
+func HandleExtraSpecDeletionSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/flavors/10/os-extra_specs/hw:cpu_policy", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusOK)
+	})
+}
@@ -1,6 +1,7 @@
 package flavors
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/rackspace/gophercloud"
@@ -101,3 +102,234 @@ func IDFromName(client *gophercloud.ServiceClient, name string) (string, error)
 		return "", fmt.Errorf("Found %d flavors matching %s", flavorCount, name)
 	}
 }
+
+// ListAll is like ListDetail, but returns the flavors of every page at once.
+func ListAll(client *gophercloud.ServiceClient, opts ListOptsBuilder) ([]Flavor, error) {
+	pages, err := ListDetail(client, opts).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	return ExtractFlavors(pages)
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the Create request.
+type CreateOptsBuilder interface {
+	ToFlavorCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts specifies the hardware configuration of a new flavor.
+type CreateOpts struct {
+	// Name is the name of the flavor. It's required.
+	Name string
+
+	// ID is the ID of the flavor. Nova generates a UUID when it's empty or "auto".
+	ID string
+
+	// RAM is the amount of memory, in MB, and VCPUs the number of virtual CPUs. Both are required.
+	RAM   int
+	VCPUs int
+
+	// Disk is the size of the root disk, in GB. A flavor with a Disk of 0 boots servers with a root
+	// disk of the size of their image.
+	Disk int
+
+	// Swap is the size of the swap disk, in MB, and Ephemeral the size of the ephemeral disk, in GB.
+	// Servers get no such disks when they're 0.
+	Swap      int
+	Ephemeral int
+
+	// RxTxFactor scales the bandwidth that servers of the flavor get, relative to the network's
+	// base rate. Nova uses 1.0 when it's 0.
+	RxTxFactor float64
+
+	// IsPublic determines whether every project may use the flavor, or only the projects that have
+	// been granted access with AddAccess. Flavors are public by default.
+	IsPublic *bool
+}
+
+// ToFlavorCreateMap assembles a request body based on the contents of a CreateOpts.
+func (opts CreateOpts) ToFlavorCreateMap() (map[string]interface{}, error) {
+	if opts.Name == "" {
+		return nil, errors.New("A flavor name must be provided.")
+	}
+	if opts.RAM <= 0 {
+		return nil, errors.New("A flavor must have a positive amount of RAM.")
+	}
+	if opts.VCPUs <= 0 {
+		return nil, errors.New("A flavor must have a positive number of VCPUs.")
+	}
+
+	f := map[string]interface{}{
+		"name":  opts.Name,
+		"ram":   opts.RAM,
+		"vcpus": opts.VCPUs,
+		"disk":  opts.Disk,
+	}
+	if opts.ID != "" {
+		f["id"] = opts.ID
+	}
+	if opts.Swap != 0 {
+		f["swap"] = opts.Swap
+	}
+	if opts.Ephemeral != 0 {
+		f["OS-FLV-EXT-DATA:ephemeral"] = opts.Ephemeral
+	}
+	if opts.RxTxFactor != 0 {
+		f["rxtx_factor"] = opts.RxTxFactor
+	}
+	if opts.IsPublic != nil {
+		f["os-flavor-access:is_public"] = *opts.IsPublic
+	}
+
+	return map[string]interface{}{"flavor": f}, nil
+}
+
+// Create adds a new flavor. It requires administrative rights.
+func Create(client *gophercloud.ServiceClient, opts CreateOptsBuilder) CreateResult {
+	var res CreateResult
+
+	reqBody, err := opts.ToFlavorCreateMap()
+	if err != nil {
+		res.Err = err
+		return res
+	}
+
+	_, res.Err = client.Post(createURL(client), reqBody, &res.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return res
+}
+
+// Delete removes a flavor. Servers that were created with the flavor keep running. It requires
+// administrative rights.
+func Delete(client *gophercloud.ServiceClient, id string) DeleteResult {
+	var res DeleteResult
+	_, res.Err = client.Delete(getURL(client, id), nil)
+	return res
+}
+
+// ListAccesses enumerates the projects that have been granted access to a private flavor.
+func ListAccesses(client *gophercloud.ServiceClient, id string) pagination.Pager {
+	createPage := func(r pagination.PageResult) pagination.Page {
+		return AccessPage{pagination.SinglePageBase(r)}
+	}
+
+	return pagination.NewPager(client, accessURL(client, id), createPage)
+}
+
+// AccessOptsBuilder allows extensions to add additional parameters to the AddAccess and
+// RemoveAccess requests.
+type AccessOptsBuilder interface {
+	ToFlavorAccessMap() (map[string]interface{}, error)
+}
+
+// AccessOpts identifies the project that is granted or denied access to a flavor.
+type AccessOpts struct {
+	// Tenant is the ID of the project. It's required.
+	Tenant string
+}
+
+// ToFlavorAccessMap assembles the body of an access action based on the contents of an
+// AccessOpts.
+func (opts AccessOpts) ToFlavorAccessMap() (map[string]interface{}, error) {
+	if opts.Tenant == "" {
+		return nil, errors.New("A tenant must be provided.")
+	}
+	return map[string]interface{}{"tenant": opts.Tenant}, nil
+}
+
+func accessAction(client *gophercloud.ServiceClient, id, action string, opts AccessOptsBuilder) AccessResult {
+	var res AccessResult
+
+	access, err := opts.ToFlavorAccessMap()
+	if err != nil {
+		res.Err = err
+		return res
+	}
+
+	reqBody := map[string]interface{}{action: access}
+	_, res.Err = client.Post(actionURL(client, id), reqBody, &res.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return res
+}
+
+// AddAccess grants a project access to a private flavor. Its result lists every project that has
+// access to the flavor.
+func AddAccess(client *gophercloud.ServiceClient, id string, opts AccessOptsBuilder) AccessResult {
+	return accessAction(client, id, "addTenantAccess", opts)
+}
+
+// RemoveAccess revokes a project's access to a private flavor. Its result lists every project that
+// still has access to the flavor.
+func RemoveAccess(client *gophercloud.ServiceClient, id string, opts AccessOptsBuilder) AccessResult {
+	return accessAction(client, id, "removeTenantAccess", opts)
+}
+
+// ListExtraSpecs retrieves all of a flavor's extra specs, such as "hw:cpu_policy" or
+// "aggregate_instance_extra_specs:ssd".
+func ListExtraSpecs(client *gophercloud.ServiceClient, id string) ExtraSpecsResult {
+	var res ExtraSpecsResult
+	_, res.Err = client.Get(extraSpecsURL(client, id), &res.Body, nil)
+	return res
+}
+
+// GetExtraSpec retrieves a single extra spec of a flavor.
+func GetExtraSpec(client *gophercloud.ServiceClient, id, key string) ExtraSpecResult {
+	var res ExtraSpecResult
+	_, res.Err = client.Get(extraSpecURL(client, id, key), &res.Body, nil)
+	return res
+}
+
+// ExtraSpecsOptsBuilder allows extensions to add additional parameters to the CreateExtraSpecs
+// request.
+type ExtraSpecsOptsBuilder interface {
+	ToFlavorExtraSpecsCreateMap() (map[string]interface{}, error)
+}
+
+// ExtraSpecsOpts are extra specs to set on a flavor, keyed by name.
+type ExtraSpecsOpts map[string]string
+
+// ToFlavorExtraSpecsCreateMap assembles a request body based on the contents of an ExtraSpecsOpts.
+func (opts ExtraSpecsOpts) ToFlavorExtraSpecsCreateMap() (map[string]interface{}, error) {
+	if len(opts) == 0 {
+		return nil, errors.New("At least one extra spec must be provided.")
+	}
+	return map[string]interface{}{"extra_specs": opts}, nil
+}
+
+// CreateExtraSpecs sets extra specs on a flavor, replacing the values of any that are set
+// already. Its result holds the extra specs that were set.
+func CreateExtraSpecs(client *gophercloud.ServiceClient, id string, opts ExtraSpecsOptsBuilder) ExtraSpecsResult {
+	var res ExtraSpecsResult
+
+	reqBody, err := opts.ToFlavorExtraSpecsCreateMap()
+	if err != nil {
+		res.Err = err
+		return res
+	}
+
+	_, res.Err = client.Post(extraSpecsURL(client, id), reqBody, &res.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return res
+}
+
+// UpdateExtraSpec sets the value of a single extra spec of a flavor.
+func UpdateExtraSpec(client *gophercloud.ServiceClient, id, key, value string) ExtraSpecResult {
+	var res ExtraSpecResult
+	reqBody := map[string]interface{}{key: value}
+	_, res.Err = client.Put(extraSpecURL(client, id, key), reqBody, &res.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return res
+}
+
+// DeleteExtraSpec removes a single extra spec from a flavor.
+func DeleteExtraSpec(client *gophercloud.ServiceClient, id, key string) DeleteResult {
+	var res DeleteResult
+	_, res.Err = client.Delete(extraSpecURL(client, id, key), &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return res
+}
@@ -127,3 +127,133 @@ func TestGetFlavor(t *testing.T) {
 		t.Errorf("Expected %#v, but was %#v", expected, actual)
 	}
 }
+
+func TestCreateFlavor(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleFlavorCreationSuccessfully(t)
+
+	isPublic := false
+	opts := CreateOpts{
+		ID:         "10",
+		Name:       "m1.pinned",
+		Disk:       20,
+		RAM:        4096,
+		VCPUs:      2,
+		Swap:       512,
+		RxTxFactor: 2.0,
+		Ephemeral:  10,
+		IsPublic:   &isPublic,
+	}
+	actual, err := Create(fake.ServiceClient(), opts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &CreatedFlavor, actual)
+}
+
+func TestCreateFlavorRequiresName(t *testing.T) {
+	res := Create(fake.ServiceClient(), CreateOpts{RAM: 512, VCPUs: 1})
+	if res.Err == nil {
+		t.Fatalf("Expected an error for a flavor without a name")
+	}
+}
+
+func TestDeleteFlavor(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleFlavorDeletionSuccessfully(t)
+
+	res := Delete(fake.ServiceClient(), "10")
+	th.AssertNoErr(t, res.Err)
+}
+
+func TestListAccesses(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleAccessListSuccessfully(t)
+
+	pages := 0
+	err := ListAccesses(fake.ServiceClient(), "10").EachPage(func(page pagination.Page) (bool, error) {
+		pages++
+
+		actual, err := ExtractAccesses(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, ExpectedAccesses, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 1, pages)
+}
+
+func TestAddAccess(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleAccessActionSuccessfully(t, "addTenantAccess")
+
+	actual, err := AddAccess(fake.ServiceClient(), "10", AccessOpts{Tenant: "2f6f7e28fe6e4c7aa9b6ab1e8d3cd2b9"}).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedAccesses, actual)
+}
+
+func TestRemoveAccess(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleAccessActionSuccessfully(t, "removeTenantAccess")
+
+	actual, err := RemoveAccess(fake.ServiceClient(), "10", AccessOpts{Tenant: "2f6f7e28fe6e4c7aa9b6ab1e8d3cd2b9"}).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedAccesses, actual)
+}
+
+func TestListExtraSpecs(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleExtraSpecsListSuccessfully(t)
+
+	actual, err := ListExtraSpecs(fake.ServiceClient(), "10").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedExtraSpecs, actual)
+}
+
+func TestGetExtraSpec(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleExtraSpecGetSuccessfully(t)
+
+	actual, err := GetExtraSpec(fake.ServiceClient(), "10", "hw:cpu_policy").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedExtraSpec, actual)
+}
+
+func TestCreateExtraSpecs(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleExtraSpecsCreationSuccessfully(t)
+
+	opts := ExtraSpecsOpts{
+		"hw:cpu_policy": "dedicated",
+		"hw:numa_nodes": "1",
+	}
+	actual, err := CreateExtraSpecs(fake.ServiceClient(), "10", opts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedExtraSpecs, actual)
+}
+
+func TestUpdateExtraSpec(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleExtraSpecUpdateSuccessfully(t)
+
+	actual, err := UpdateExtraSpec(fake.ServiceClient(), "10", "hw:cpu_policy", "dedicated").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedExtraSpec, actual)
+}
+
+func TestDeleteExtraSpec(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleExtraSpecDeletionSuccessfully(t)
+
+	res := DeleteExtraSpec(fake.ServiceClient(), "10", "hw:cpu_policy")
+	th.AssertNoErr(t, res.Err)
+}
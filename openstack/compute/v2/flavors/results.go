@@ -12,13 +12,27 @@ import (
 // ErrCannotInterpret is returned by an Extract call if the response body doesn't have the expected structure.
 var ErrCannotInterpet = errors.New("Unable to interpret a response body.")
 
+type commonResult struct {
+	gophercloud.Result
+}
+
 // GetResult temporarily holds the response from a Get call.
 type GetResult struct {
-	gophercloud.Result
+	commonResult
+}
+
+// CreateResult temporarily holds the response from a Create call.
+type CreateResult struct {
+	commonResult
+}
+
+// DeleteResult temporarily holds the response from a Delete or DeleteExtraSpec call.
+type DeleteResult struct {
+	gophercloud.ErrResult
 }
 
-// Extract provides access to the individual Flavor returned by the Get function.
-func (gr GetResult) Extract() (*Flavor, error) {
+// Extract provides access to the individual Flavor returned by the Get and Create functions.
+func (gr commonResult) Extract() (*Flavor, error) {
 	if gr.Err != nil {
 		return nil, gr.Err
 	}
@@ -60,6 +74,13 @@ type Flavor struct {
 
 	// VCPUs indicates how many (virtual) CPUs are available for this flavor.
 	VCPUs int `mapstructure:"vcpus"`
+
+	// Ephemeral is the size of the ephemeral disk, in GB.
+	Ephemeral int `mapstructure:"OS-FLV-EXT-DATA:ephemeral"`
+
+	// IsPublic indicates whether every project may use the flavor. It's false for private flavors,
+	// and also when the cloud doesn't report it.
+	IsPublic bool `mapstructure:"os-flavor-access:is_public"`
 }
 
 // FlavorPage contains a single page of the response from a List call.
@@ -67,6 +88,11 @@ type FlavorPage struct {
 	pagination.LinkedPageBase
 }
 
+// CollectionKey returns "flavors", leaving out "flavors_links".
+func (page FlavorPage) CollectionKey() string {
+	return "flavors"
+}
+
 // IsEmpty determines if a page contains any results.
 func (p FlavorPage) IsEmpty() (bool, error) {
 	flavors, err := ExtractFlavors(p)
@@ -120,3 +146,89 @@ func ExtractFlavors(page pagination.Page) ([]Flavor, error) {
 
 	return container.Flavors, nil
 }
+
+// FlavorAccess records that a project has been granted access to a private flavor.
+type FlavorAccess struct {
+	FlavorID string `mapstructure:"flavor_id"`
+	TenantID string `mapstructure:"tenant_id"`
+}
+
+// AccessPage contains the response from a ListAccesses call.
+type AccessPage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty determines if a page contains any results.
+func (p AccessPage) IsEmpty() (bool, error) {
+	accesses, err := ExtractAccesses(p)
+	if err != nil {
+		return true, err
+	}
+	return len(accesses) == 0, nil
+}
+
+// ExtractAccesses provides access to the list of flavor accesses in a page acquired from the
+// ListAccesses operation.
+func ExtractAccesses(page pagination.Page) ([]FlavorAccess, error) {
+	var container struct {
+		FlavorAccesses []FlavorAccess `mapstructure:"flavor_access"`
+	}
+
+	err := mapstructure.Decode(page.(AccessPage).Body, &container)
+	return container.FlavorAccesses, err
+}
+
+// AccessResult temporarily holds the response from an AddAccess or RemoveAccess call.
+type AccessResult struct {
+	gophercloud.Result
+}
+
+// Extract provides access to the list of projects that have access to the flavor after an
+// AddAccess or RemoveAccess call.
+func (r AccessResult) Extract() ([]FlavorAccess, error) {
+	if r.Err != nil {
+		return nil, r.Err
+	}
+
+	var container struct {
+		FlavorAccesses []FlavorAccess `mapstructure:"flavor_access"`
+	}
+
+	err := mapstructure.Decode(r.Body, &container)
+	return container.FlavorAccesses, err
+}
+
+// ExtraSpecsResult temporarily holds the response from a ListExtraSpecs or CreateExtraSpecs call.
+type ExtraSpecsResult struct {
+	gophercloud.Result
+}
+
+// Extract provides access to the extra specs, keyed by name.
+func (r ExtraSpecsResult) Extract() (map[string]string, error) {
+	if r.Err != nil {
+		return nil, r.Err
+	}
+
+	var container struct {
+		ExtraSpecs map[string]string `mapstructure:"extra_specs"`
+	}
+
+	err := mapstructure.Decode(r.Body, &container)
+	return container.ExtraSpecs, err
+}
+
+// ExtraSpecResult temporarily holds the response from a GetExtraSpec or UpdateExtraSpec call.
+type ExtraSpecResult struct {
+	gophercloud.Result
+}
+
+// Extract provides access to the extra spec, as a map with a single entry.
+func (r ExtraSpecResult) Extract() (map[string]string, error) {
+	if r.Err != nil {
+		return nil, r.Err
+	}
+
+	var spec map[string]string
+	err := mapstructure.Decode(r.Body, &spec)
+	return spec, err
+}
@@ -24,3 +24,9 @@ func TestListURL(t *testing.T) {
 	expected := endpoint + "flavors/detail"
 	th.CheckEquals(t, expected, actual)
 }
+
+func TestExtraSpecURL(t *testing.T) {
+	actual := extraSpecURL(endpointClient(), "foo", "hw:cpu_policy")
+	expected := endpoint + "flavors/foo/os-extra_specs/hw:cpu_policy"
+	th.CheckEquals(t, expected, actual)
+}
@@ -107,3 +107,12 @@ func IDFromName(client *gophercloud.ServiceClient, name string) (string, error)
 		return "", fmt.Errorf("Found %d images matching %s", imageCount, name)
 	}
 }
+
+// ListAll is like ListDetail, but returns the images of every page at once.
+func ListAll(client *gophercloud.ServiceClient, opts ListOptsBuilder) ([]Image, error) {
+	pages, err := ListDetail(client, opts).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	return ExtractImages(pages)
+}
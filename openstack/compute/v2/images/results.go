@@ -61,6 +61,11 @@ type ImagePage struct {
 	pagination.LinkedPageBase
 }
 
+// CollectionKey returns "images", leaving out "images_links".
+func (page ImagePage) CollectionKey() string {
+	return "images"
+}
+
 // IsEmpty returns true if a page contains no Image results.
 func (page ImagePage) IsEmpty() (bool, error) {
 	images, err := ExtractImages(page)
@@ -690,3 +690,22 @@ func HandlePasswordGetSuccessfully(t *testing.T) {
 		fmt.Fprintf(w, ServerPasswordBody)
 	})
 }
+
+// ConsoleOutputBody contains the canned body of a servers.ShowConsoleOutput response.
+const ConsoleOutputBody = `{
+	"output": "[    0.000000] Initializing cgroup subsys cpuset\nlogin: "
+}`
+
+// HandleShowConsoleOutputSuccessfully sets up the test server to respond to a ShowConsoleOutput
+// request for the last 50 lines of the log.
+func HandleShowConsoleOutputSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/servers/1234asdf/action", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, `{ "os-getConsoleOutput": { "length": 50 } }`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, ConsoleOutputBody)
+	})
+}
@@ -52,6 +52,11 @@ type ListOpts struct {
 
 	// Bool to show all tenants
 	AllTenants bool `q:"all_tenants"`
+
+	// Server attributes to sort by, and the direction (asc or desc) of each,
+	// paired up in order.
+	SortKeys []string `q:"sort_key"`
+	SortDirs []string `q:"sort_dir"`
 }
 
 // ToServerListQuery formats a ListOpts into a query string.
@@ -183,6 +188,13 @@ type CreateOpts struct {
 
 	// AccessIPv6 [optional] specifies an IPv6 address for the instance.
 	AccessIPv6 string
+
+	// Description [optional] sets a free-form description of the server. It requires
+	// microversion 2.19 or later.
+	Description string
+
+	// Tags [optional] lists tags to apply to the server. It requires microversion 2.52 or later.
+	Tags []string
 }
 
 // ToServerCreateMap assembles a request body based on the contents of a CreateOpts.
@@ -217,6 +229,12 @@ func (opts CreateOpts) ToServerCreateMap() (map[string]interface{}, error) {
 	if opts.AccessIPv6 != "" {
 		server["accessIPv6"] = opts.AccessIPv6
 	}
+	if opts.Description != "" {
+		server["description"] = opts.Description
+	}
+	if len(opts.Tags) > 0 {
+		server["tags"] = opts.Tags
+	}
 
 	if len(opts.SecurityGroups) > 0 {
 		securityGroups := make([]map[string]interface{}, len(opts.SecurityGroups))
@@ -870,3 +888,56 @@ func GetPassword(client *gophercloud.ServiceClient, serverId string) GetPassword
 	})
 	return res
 }
+
+// ShowConsoleOutputOptsBuilder is an interface that allows extensions to override the
+// default structure of a ShowConsoleOutput request.
+type ShowConsoleOutputOptsBuilder interface {
+	ToServerShowConsoleOutputMap() (map[string]interface{}, error)
+}
+
+// ShowConsoleOutputOpts controls how much of a server's console log ShowConsoleOutput returns.
+type ShowConsoleOutputOpts struct {
+	// Length is the number of lines to return from the end of the log. If it's 0, the whole log is
+	// returned.
+	Length int
+}
+
+// ToServerShowConsoleOutputMap formats a ShowConsoleOutputOpts as a map that can be used as a JSON
+// request body for the ShowConsoleOutput request.
+func (opts ShowConsoleOutputOpts) ToServerShowConsoleOutputMap() (map[string]interface{}, error) {
+	if opts.Length < 0 {
+		return nil, errors.New("ShowConsoleOutputOpts.Length must be 0 or greater.")
+	}
+
+	output := make(map[string]interface{})
+	if opts.Length > 0 {
+		output["length"] = opts.Length
+	}
+	return map[string]interface{}{"os-getConsoleOutput": output}, nil
+}
+
+// ShowConsoleOutput retrieves the console log of a server, which includes the messages it printed
+// while booting.
+func ShowConsoleOutput(client *gophercloud.ServiceClient, id string, opts ShowConsoleOutputOptsBuilder) ShowConsoleOutputResult {
+	var res ShowConsoleOutputResult
+
+	reqBody, err := opts.ToServerShowConsoleOutputMap()
+	if err != nil {
+		res.Err = err
+		return res
+	}
+
+	_, res.Err = client.Post(actionURL(client, id), reqBody, &res.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return res
+}
+
+// ListAll is like List, but returns the servers of every page at once.
+func ListAll(client *gophercloud.ServiceClient, opts ListOptsBuilder) ([]Server, error) {
+	pages, err := List(client, opts).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	return ExtractServers(pages)
+}
@@ -3,9 +3,11 @@ package servers
 import (
 	"encoding/base64"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"testing"
 
+	"github.com/rackspace/gophercloud"
 	"github.com/rackspace/gophercloud/pagination"
 	th "github.com/rackspace/gophercloud/testhelper"
 	"github.com/rackspace/gophercloud/testhelper/client"
@@ -54,6 +56,16 @@ func TestListAllServers(t *testing.T) {
 	th.CheckDeepEquals(t, ServerDerp, actual[1])
 }
 
+func TestListAll(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleServerListSuccessfully(t)
+
+	actual, err := ListAll(client.ServiceClient(), ListOpts{})
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []Server{ServerHerp, ServerDerp}, actual)
+}
+
 func TestCreateServer(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
@@ -101,6 +113,44 @@ func TestGetServer(t *testing.T) {
 	th.CheckDeepEquals(t, ServerDerp, *actual)
 }
 
+func TestGetServerMicroversion(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/servers/1234asdf", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-OpenStack-Nova-API-Version", "2.26")
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `
+			{
+				"server": {
+					"id": "1234asdf",
+					"name": "derp",
+					"description": "a server",
+					"tags": ["web", "prod"],
+					"flavor": {
+						"vcpus": 1,
+						"ram": 512,
+						"disk": 1,
+						"original_name": "m1.tiny",
+						"extra_specs": {}
+					},
+					"locked": false
+				}
+			}
+		`)
+	})
+
+	sc := client.ServiceClient()
+	sc.Type = "compute"
+	actual, err := Get(sc.WithMicroversion("2.26"), "1234asdf").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "a server", actual.Description)
+	th.CheckDeepEquals(t, []string{"web", "prod"}, actual.Tags)
+	th.CheckEquals(t, "m1.tiny", actual.Flavor["original_name"])
+}
+
 func TestUpdateServer(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
@@ -133,6 +183,23 @@ func TestGetPassword(t *testing.T) {
 	th.AssertNoErr(t, res.Err)
 }
 
+func TestShowConsoleOutput(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleShowConsoleOutputSuccessfully(t)
+
+	output, err := ShowConsoleOutput(client.ServiceClient(), "1234asdf", ShowConsoleOutputOpts{Length: 50}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "[    0.000000] Initializing cgroup subsys cpuset\nlogin: ", output)
+}
+
+func TestShowConsoleOutputRejectsNegativeLength(t *testing.T) {
+	res := ShowConsoleOutput(client.ServiceClient(), "1234asdf", ShowConsoleOutputOpts{Length: -1})
+	if res.Err == nil {
+		t.Fatalf("Expected an error for a negative length")
+	}
+}
+
 func TestRebootServer(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
@@ -389,3 +456,35 @@ func TestMarshalPersonality(t *testing.T) {
 		t.Fatal("file contents incorrect")
 	}
 }
+
+func TestWaitForStatusFailsOnError(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/servers/1234", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"server": {"id": "1234", "status": "ERROR"}}`)
+	})
+
+	err := WaitForStatus(client.ServiceClient(), "1234", "ACTIVE", 60)
+	casted, ok := err.(*gophercloud.FailedStatusError)
+	if !ok {
+		t.Fatalf("Expected a FailedStatusError, got %#v", err)
+	}
+	th.CheckEquals(t, "ERROR", casted.Status)
+}
+
+func TestWaitForStatusZeroSecondsTimesOut(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/servers/1234", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Expected no poll with a timeout of 0 seconds")
+	})
+
+	err := WaitForStatus(client.ServiceClient(), "1234", "ACTIVE", 0)
+	if _, ok := err.(*gophercloud.WaitTimeoutError); !ok {
+		t.Fatalf("Expected a WaitTimeoutError, got %#v", err)
+	}
+}
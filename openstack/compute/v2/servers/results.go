@@ -142,6 +142,25 @@ func (res CreateImageResult) ExtractImageID() (string, error) {
 	return imageId, nil
 }
 
+// ShowConsoleOutputResult represents the result of a ShowConsoleOutput operation.
+type ShowConsoleOutputResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a ShowConsoleOutputResult as the server's console log.
+func (r ShowConsoleOutputResult) Extract() (string, error) {
+	if r.Err != nil {
+		return "", r.Err
+	}
+
+	var response struct {
+		Output string `mapstructure:"output"`
+	}
+
+	err := mapstructure.Decode(r.Body, &response)
+	return response.Output, err
+}
+
 // Extract interprets any RescueResult as an AdminPass, if possible.
 func (r RescueResult) Extract() (string, error) {
 	if r.Err != nil {
@@ -157,6 +176,8 @@ func (r RescueResult) Extract() (string, error) {
 }
 
 // Server exposes only the standard OpenStack fields corresponding to a given server on the user's account.
+// Fields that newer microversions add or reshape, such as the embedded flavor of microversion 2.47,
+// are decoded where Server has room for them and ignored otherwise.
 type Server struct {
 	// ID uniquely identifies this server amongst all other servers, including those not accessible to the current tenant.
 	ID string
@@ -210,6 +231,13 @@ type Server struct {
 
 	// SecurityGroups includes the security groups that this instance has applied to it
 	SecurityGroups []map[string]interface{} `json:"security_groups" mapstructure:"security_groups"`
+
+	// Description is the server's free-form description. It's only returned by microversion 2.19
+	// and later.
+	Description string `json:"description" mapstructure:"description"`
+
+	// Tags lists the server's tags. It's only returned by microversion 2.26 and later.
+	Tags []string `json:"tags" mapstructure:"tags"`
 }
 
 // ServerPage abstracts the raw results of making a List() request against the API.
@@ -219,6 +247,11 @@ type ServerPage struct {
 	pagination.LinkedPageBase
 }
 
+// CollectionKey returns "servers", leaving out "servers_links".
+func (page ServerPage) CollectionKey() string {
+	return "servers"
+}
+
 // IsEmpty returns true if a page contains no Server results.
 func (page ServerPage) IsEmpty() (bool, error) {
 	servers, err := ExtractServers(page)
@@ -2,19 +2,28 @@ package servers
 
 import "github.com/rackspace/gophercloud"
 
-// WaitForStatus will continually poll a server until it successfully transitions to a specified
-// status. It will do this for at most the number of seconds specified.
-func WaitForStatus(c *gophercloud.ServiceClient, id, status string, secs int) error {
-	return gophercloud.WaitFor(secs, func() (bool, error) {
+// StatusFunc returns a gophercloud.StatusFunc that reports the status of a server, for use with a
+// gophercloud.Waiter.
+func StatusFunc(c *gophercloud.ServiceClient, id string) gophercloud.StatusFunc {
+	return func() (string, error) {
 		current, err := Get(c, id).Extract()
 		if err != nil {
-			return false, err
+			return "", err
 		}
+		return current.Status, nil
+	}
+}
 
-		if current.Status == status {
-			return true, nil
-		}
+// failureStatuses are the statuses from which a server won't reach any other status on its own.
+var failureStatuses = []string{"ERROR"}
 
-		return false, nil
-	})
+// WaitForStatus will continually poll a server until it successfully transitions to a specified
+// status. It will do this for at most the number of seconds specified, and gives up as soon as the
+// server goes into the ERROR state.
+func WaitForStatus(c *gophercloud.ServiceClient, id, status string, secs int) error {
+	w := gophercloud.Waiter{
+		Target:  []string{status},
+		Failure: failureStatuses,
+	}
+	return w.WaitSeconds(c.Context, secs, StatusFunc(c, id))
 }
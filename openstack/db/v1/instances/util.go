@@ -0,0 +1,29 @@
+package instances
+
+import "github.com/rackspace/gophercloud"
+
+// StatusFunc returns a gophercloud.StatusFunc that reports the status of a database instance, for use
+// with a gophercloud.Waiter.
+func StatusFunc(c *gophercloud.ServiceClient, id string) gophercloud.StatusFunc {
+	return func() (string, error) {
+		current, err := Get(c, id).Extract()
+		if err != nil {
+			return "", err
+		}
+		return current.Status, nil
+	}
+}
+
+// failureStatuses are the statuses from which a database instance won't reach any other status on its own.
+var failureStatuses = []string{"ERROR", "FAILED"}
+
+// WaitForStatus will continually poll a database instance until it successfully transitions to a
+// specified status. It will do this for at most the number of seconds specified, and gives up as
+// soon as the instance goes into the ERROR or FAILED state.
+func WaitForStatus(c *gophercloud.ServiceClient, id, status string, secs int) error {
+	w := gophercloud.Waiter{
+		Target:  []string{status},
+		Failure: failureStatuses,
+	}
+	return w.WaitSeconds(c.Context, secs, StatusFunc(c, id))
+}
@@ -89,3 +89,53 @@ func V3EndpointURL(catalog *tokens3.ServiceCatalog, opts gophercloud.EndpointOpt
 	// Report an error if there were no matching endpoints.
 	return "", gophercloud.ErrEndpointNotFound
 }
+
+// V2ServiceCatalog converts a ServiceCatalog acquired from the v2 identity service into the
+// version-agnostic form exposed by ProviderClient.ServiceCatalog. Each v2 endpoint yields one
+// CatalogEndpoint per URL it provides.
+func V2ServiceCatalog(catalog *tokens2.ServiceCatalog) *gophercloud.ServiceCatalog {
+	services := make([]gophercloud.CatalogService, 0, len(catalog.Entries))
+	for _, entry := range catalog.Entries {
+		service := gophercloud.CatalogService{Type: entry.Type, Name: entry.Name}
+		for _, endpoint := range entry.Endpoints {
+			urls := []struct {
+				availability gophercloud.Availability
+				url          string
+			}{
+				{gophercloud.AvailabilityPublic, endpoint.PublicURL},
+				{gophercloud.AvailabilityInternal, endpoint.InternalURL},
+				{gophercloud.AvailabilityAdmin, endpoint.AdminURL},
+			}
+			for _, u := range urls {
+				if u.url == "" {
+					continue
+				}
+				service.Endpoints = append(service.Endpoints, gophercloud.CatalogEndpoint{
+					Region:       endpoint.Region,
+					Availability: u.availability,
+					URL:          gophercloud.NormalizeURL(u.url),
+				})
+			}
+		}
+		services = append(services, service)
+	}
+	return &gophercloud.ServiceCatalog{Services: services}
+}
+
+// V3ServiceCatalog converts a ServiceCatalog acquired from the v3 identity service into the
+// version-agnostic form exposed by ProviderClient.ServiceCatalog.
+func V3ServiceCatalog(catalog *tokens3.ServiceCatalog) *gophercloud.ServiceCatalog {
+	services := make([]gophercloud.CatalogService, 0, len(catalog.Entries))
+	for _, entry := range catalog.Entries {
+		service := gophercloud.CatalogService{Type: entry.Type, Name: entry.Name}
+		for _, endpoint := range entry.Endpoints {
+			service.Endpoints = append(service.Endpoints, gophercloud.CatalogEndpoint{
+				Region:       endpoint.Region,
+				Availability: gophercloud.Availability(endpoint.Interface),
+				URL:          gophercloud.NormalizeURL(endpoint.URL),
+			})
+		}
+		services = append(services, service)
+	}
+	return &gophercloud.ServiceCatalog{Services: services}
+}
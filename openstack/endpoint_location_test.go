@@ -226,3 +226,36 @@ func TestV3EndpointBadAvailability(t *testing.T) {
 	})
 	th.CheckEquals(t, "Unexpected availability in endpoint query: wat", err.Error())
 }
+
+func TestV2ServiceCatalog(t *testing.T) {
+	catalog := V2ServiceCatalog(&catalog2)
+
+	th.CheckEquals(t, 3, len(catalog.Services))
+	th.CheckDeepEquals(t, gophercloud.CatalogService{
+		Type: "same",
+		Name: "same",
+		Endpoints: []gophercloud.CatalogEndpoint{
+			{Region: "same", Availability: gophercloud.AvailabilityPublic, URL: "https://public.correct.com/"},
+			{Region: "same", Availability: gophercloud.AvailabilityInternal, URL: "https://internal.correct.com/"},
+			{Region: "same", Availability: gophercloud.AvailabilityAdmin, URL: "https://admin.correct.com/"},
+			{Region: "different", Availability: gophercloud.AvailabilityPublic, URL: "https://badregion.com/"},
+		},
+	}, catalog.Services[0])
+}
+
+func TestV3ServiceCatalog(t *testing.T) {
+	catalog := V3ServiceCatalog(&catalog3)
+
+	th.CheckEquals(t, len(catalog3.Entries), len(catalog.Services))
+	th.CheckDeepEquals(t, []string{"different", "same"}, catalog.Types())
+	th.CheckDeepEquals(t, []gophercloud.Availability{"admin", "internal", "public"}, catalog.Availabilities())
+
+	found := catalog.Find(gophercloud.EndpointOpts{Type: "same", Name: "same", Region: "same", Availability: gophercloud.AvailabilityInternal})
+	th.CheckDeepEquals(t, []gophercloud.CatalogService{{
+		Type: "same",
+		Name: "same",
+		Endpoints: []gophercloud.CatalogEndpoint{
+			{Region: "same", Availability: gophercloud.AvailabilityInternal, URL: "https://internal.correct.com/"},
+		},
+	}}, found)
+}
@@ -31,3 +31,12 @@ func List(client *gophercloud.ServiceClient, opts *ListOpts) pagination.Pager {
 
 	return pagination.NewPager(client, url, createPage)
 }
+
+// ListAll returns every tenant that the token grants access to.
+func ListAll(client *gophercloud.ServiceClient, opts *ListOpts) ([]Tenant, error) {
+	pages, err := List(client, opts).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	return ExtractTenants(pages)
+}
@@ -26,6 +26,11 @@ type TenantPage struct {
 	pagination.LinkedPageBase
 }
 
+// CollectionKey returns "tenants"; the body also carries "tenants_links".
+func (page TenantPage) CollectionKey() string {
+	return "tenants"
+}
+
 // IsEmpty determines whether or not a page of Tenants contains any results.
 func (page TenantPage) IsEmpty() (bool, error) {
 	tenants, err := ExtractTenants(page)
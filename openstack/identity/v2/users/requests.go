@@ -159,3 +159,12 @@ func ListRoles(client *gophercloud.ServiceClient, tenantID, userID string) pagin
 
 	return pagination.NewPager(client, listRolesURL(client, tenantID, userID), createPage)
 }
+
+// ListAll returns every user known to the identity service.
+func ListAll(client *gophercloud.ServiceClient) ([]User, error) {
+	pages, err := List(client).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	return ExtractUsers(pages)
+}
@@ -43,6 +43,11 @@ type UserPage struct {
 	pagination.SinglePageBase
 }
 
+// CollectionKey returns "users".
+func (page UserPage) CollectionKey() string {
+	return "users"
+}
+
 // RolePage is a single page of a user Role collection.
 type RolePage struct {
 	pagination.SinglePageBase
@@ -0,0 +1,4 @@
+// Package domains provides information and interaction with the domains API
+// resource for the OpenStack Identity service. A domain is a container for
+// projects, users and groups, and is the top of the project hierarchy.
+package domains
@@ -0,0 +1,143 @@
+// +build fixtures
+
+package domains
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/rackspace/gophercloud/testhelper"
+	fake "github.com/rackspace/gophercloud/testhelper/client"
+)
+
+// DomainOutput is a domain as the service returns it.
+const DomainOutput = `
+{
+	"domain": {
+		"id": "1789d1",
+		"name": "acme",
+		"description": "ACME Corporation",
+		"enabled": true,
+		"links": {
+			"self": "http://localhost:5000/v3/domains/1789d1"
+		}
+	}
+}
+`
+
+// ListOutput is a page of domains as the service returns it.
+const ListOutput = `
+{
+	"links": {
+		"next": null,
+		"previous": null
+	},
+	"domains": [
+		{
+			"id": "default",
+			"name": "Default",
+			"description": "The default domain",
+			"enabled": true
+		},
+		{
+			"id": "1789d1",
+			"name": "acme",
+			"description": "ACME Corporation",
+			"enabled": true
+		}
+	]
+}
+`
+
+// DefaultDomain is the first domain in ListOutput.
+var DefaultDomain = Domain{
+	ID:          "default",
+	Name:        "Default",
+	Description: "The default domain",
+	Enabled:     true,
+}
+
+// AcmeDomain is the expected result of extracting DomainOutput.
+var AcmeDomain = Domain{
+	ID:          "1789d1",
+	Name:        "acme",
+	Description: "ACME Corporation",
+	Enabled:     true,
+}
+
+// HandleListDomainsSuccessfully sets up the test server to respond to a List request.
+func HandleListDomainsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/domains", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"enabled": "true"})
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, ListOutput)
+	})
+}
+
+// HandleGetDomainSuccessfully sets up the test server to respond to a Get request.
+func HandleGetDomainSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/domains/1789d1", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, DomainOutput)
+	})
+}
+
+// HandleCreateDomainSuccessfully sets up the test server to respond to a Create request.
+func HandleCreateDomainSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/domains", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, `
+			{
+				"domain": {
+					"name": "acme",
+					"description": "ACME Corporation",
+					"enabled": true
+				}
+			}
+		`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, DomainOutput)
+	})
+}
+
+// HandleUpdateDomainSuccessfully sets up the test server to respond to an Update request that
+// disables the domain.
+func HandleUpdateDomainSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/domains/1789d1", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PATCH")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, `{ "domain": { "enabled": false } }`)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `
+			{
+				"domain": {
+					"id": "1789d1",
+					"name": "acme",
+					"description": "ACME Corporation",
+					"enabled": false
+				}
+			}
+		`)
+	})
+}
+
+// HandleDeleteDomainSuccessfully sets up the test server to respond to a Delete request.
+func HandleDeleteDomainSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/domains/1789d1", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
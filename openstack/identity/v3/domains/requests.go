@@ -0,0 +1,170 @@
+package domains
+
+import (
+	"errors"
+
+	"github.com/rackspace/gophercloud"
+	"github.com/rackspace/gophercloud/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the List request.
+type ListOptsBuilder interface {
+	ToDomainListQuery() (string, error)
+}
+
+// ListOpts allows you to filter the domains returned by the List method.
+type ListOpts struct {
+	// Name limits the results to the domain with the given name.
+	Name string `q:"name"`
+
+	// Enabled limits the results to enabled or disabled domains.
+	Enabled *bool `q:"enabled"`
+}
+
+// ToDomainListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToDomainListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), nil
+}
+
+// List enumerates the domains that the current user is allowed to see.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(client)
+	if opts != nil {
+		query, err := opts.ToDomainListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+
+	createPage := func(r pagination.PageResult) pagination.Page {
+		return DomainPage{pagination.LinkedPageBase{PageResult: r}}
+	}
+
+	return pagination.NewPager(client, url, createPage)
+}
+
+// Get returns additional information about a domain, given its ID.
+func Get(client *gophercloud.ServiceClient, domainID string) GetResult {
+	var result GetResult
+	_, result.Err = client.Get(domainURL(client, domainID), &result.Body, nil)
+	return result
+}
+
+// CreateOptsBuilder describes struct types that can be accepted by the Create call.
+type CreateOptsBuilder interface {
+	ToDomainCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts contains the attributes of a new domain.
+type CreateOpts struct {
+	// Name is the name of the domain. It's required, and must be unique.
+	Name string
+
+	// Description describes the domain.
+	Description string
+
+	// Enabled determines whether the domain is enabled. Domains are enabled by default.
+	Enabled gophercloud.EnabledState
+}
+
+// ToDomainCreateMap assembles a request body based on the contents of a CreateOpts.
+func (opts CreateOpts) ToDomainCreateMap() (map[string]interface{}, error) {
+	if opts.Name == "" {
+		return nil, errors.New("A Name must be provided")
+	}
+
+	d := map[string]interface{}{"name": opts.Name}
+	if opts.Description != "" {
+		d["description"] = opts.Description
+	}
+	if opts.Enabled != nil {
+		d["enabled"] = *opts.Enabled
+	}
+
+	return map[string]interface{}{"domain": d}, nil
+}
+
+// Create adds a new domain.
+func Create(client *gophercloud.ServiceClient, opts CreateOptsBuilder) CreateResult {
+	var result CreateResult
+
+	reqBody, err := opts.ToDomainCreateMap()
+	if err != nil {
+		result.Err = err
+		return result
+	}
+
+	_, result.Err = client.Post(listURL(client), reqBody, &result.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return result
+}
+
+// UpdateOptsBuilder allows extensions to add additional attributes to the Update request.
+type UpdateOptsBuilder interface {
+	ToDomainUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts contains the attributes of a domain that may be changed. Attributes left empty are
+// not changed.
+type UpdateOpts struct {
+	Name        string
+	Description string
+	Enabled     gophercloud.EnabledState
+}
+
+// ToDomainUpdateMap assembles a request body based on the contents of an UpdateOpts.
+func (opts UpdateOpts) ToDomainUpdateMap() (map[string]interface{}, error) {
+	d := make(map[string]interface{})
+	if opts.Name != "" {
+		d["name"] = opts.Name
+	}
+	if opts.Description != "" {
+		d["description"] = opts.Description
+	}
+	if opts.Enabled != nil {
+		d["enabled"] = *opts.Enabled
+	}
+
+	return map[string]interface{}{"domain": d}, nil
+}
+
+// Update changes the attributes of an existing domain.
+func Update(client *gophercloud.ServiceClient, domainID string, opts UpdateOptsBuilder) UpdateResult {
+	var result UpdateResult
+
+	reqBody, err := opts.ToDomainUpdateMap()
+	if err != nil {
+		result.Err = err
+		return result
+	}
+
+	_, result.Err = client.Request("PATCH", domainURL(client, domainID), gophercloud.RequestOpts{
+		JSONBody:     reqBody,
+		JSONResponse: &result.Body,
+		OkCodes:      []int{200},
+	})
+	return result
+}
+
+// Delete removes a domain, along with the projects, users and groups that it owns. The domain must
+// be disabled with Update first.
+func Delete(client *gophercloud.ServiceClient, domainID string) DeleteResult {
+	var result DeleteResult
+	_, result.Err = client.Delete(domainURL(client, domainID), nil)
+	return result
+}
+
+// ListAll returns the domains that match opts.
+func ListAll(client *gophercloud.ServiceClient, opts ListOptsBuilder) ([]Domain, error) {
+	pages, err := List(client, opts).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	return ExtractDomains(pages)
+}
@@ -0,0 +1,71 @@
+package domains
+
+import (
+	"testing"
+
+	"github.com/rackspace/gophercloud"
+	"github.com/rackspace/gophercloud/pagination"
+	th "github.com/rackspace/gophercloud/testhelper"
+	"github.com/rackspace/gophercloud/testhelper/client"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListDomainsSuccessfully(t)
+
+	enabled := true
+	count := 0
+	err := List(client.ServiceClient(), ListOpts{Enabled: &enabled}).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		actual, err := ExtractDomains(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, []Domain{DefaultDomain, AcmeDomain}, actual)
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 1, count)
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetDomainSuccessfully(t)
+
+	actual, err := Get(client.ServiceClient(), "1789d1").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, AcmeDomain, *actual)
+}
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateDomainSuccessfully(t)
+
+	opts := CreateOpts{Name: "acme", Description: "ACME Corporation", Enabled: gophercloud.Enabled}
+	actual, err := Create(client.ServiceClient(), opts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, AcmeDomain, *actual)
+}
+
+func TestUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdateDomainSuccessfully(t)
+
+	actual, err := Update(client.ServiceClient(), "1789d1", UpdateOpts{Enabled: gophercloud.Disabled}).Extract()
+	th.AssertNoErr(t, err)
+
+	expected := AcmeDomain
+	expected.Enabled = false
+	th.CheckDeepEquals(t, expected, *actual)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteDomainSuccessfully(t)
+
+	err := Delete(client.ServiceClient(), "1789d1").ExtractErr()
+	th.AssertNoErr(t, err)
+}
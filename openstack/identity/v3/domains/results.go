@@ -0,0 +1,93 @@
+package domains
+
+import (
+	"github.com/rackspace/gophercloud"
+	"github.com/rackspace/gophercloud/pagination"
+
+	"github.com/mitchellh/mapstructure"
+)
+
+// Domain is a container for projects, users and groups.
+type Domain struct {
+	// ID is the unique ID of the domain.
+	ID string `mapstructure:"id"`
+
+	// Name is the unique name of the domain.
+	Name string `mapstructure:"name"`
+
+	// Description describes the domain.
+	Description string `mapstructure:"description"`
+
+	// Enabled is false if the domain has been disabled, in which case its users can't
+	// authenticate.
+	Enabled bool `mapstructure:"enabled"`
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a GetResult, CreateResult or UpdateResult as a concrete Domain.
+// An error is returned if the original call or the extraction failed.
+func (r commonResult) Extract() (*Domain, error) {
+	if r.Err != nil {
+		return nil, r.Err
+	}
+
+	var res struct {
+		Domain Domain `mapstructure:"domain"`
+	}
+
+	err := mapstructure.Decode(r.Body, &res)
+
+	return &res.Domain, err
+}
+
+// CreateResult is the deferred result of a Create call.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult is the deferred result of a Get call.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult is the deferred result of an Update call.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult is the deferred result of a Delete call.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// DomainPage is a single page of Domain results.
+type DomainPage struct {
+	pagination.LinkedPageBase
+}
+
+// CollectionKey returns "domains".
+func (page DomainPage) CollectionKey() string {
+	return "domains"
+}
+
+// IsEmpty returns true if the page contains no results.
+func (page DomainPage) IsEmpty() (bool, error) {
+	domains, err := ExtractDomains(page)
+	if err != nil {
+		return true, err
+	}
+	return len(domains) == 0, nil
+}
+
+// ExtractDomains extracts a slice of Domains from a page acquired from List.
+func ExtractDomains(page pagination.Page) ([]Domain, error) {
+	var response struct {
+		Domains []Domain `mapstructure:"domains"`
+	}
+
+	err := mapstructure.Decode(page.(DomainPage).Body, &response)
+	return response.Domains, err
+}
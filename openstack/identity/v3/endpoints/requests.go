@@ -121,3 +121,12 @@ func Delete(client *gophercloud.ServiceClient, endpointID string) DeleteResult {
 	_, res.Err = client.Delete(endpointURL(client, endpointID), nil)
 	return res
 }
+
+// ListAll returns the endpoints that match opts.
+func ListAll(client *gophercloud.ServiceClient, opts ListOpts) ([]Endpoint, error) {
+	pages, err := List(client, opts).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	return ExtractEndpoints(pages)
+}
@@ -61,6 +61,11 @@ type EndpointPage struct {
 	pagination.LinkedPageBase
 }
 
+// CollectionKey returns "endpoints".
+func (page EndpointPage) CollectionKey() string {
+	return "endpoints"
+}
+
 // IsEmpty returns true if no Endpoints were returned.
 func (p EndpointPage) IsEmpty() (bool, error) {
 	es, err := ExtractEndpoints(p)
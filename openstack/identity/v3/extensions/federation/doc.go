@@ -0,0 +1,10 @@
+// Package federation provides information and interaction with the OS-FEDERATION
+// extension for the OpenStack Identity v3 service, which lets users authenticate
+// through external SAML or OpenID Connect identity providers.
+//
+// The identityproviders, protocols and mappings packages configure how users of
+// an external identity provider are mapped onto local users and groups, and the
+// serviceproviders package configures the service providers that tokens can be
+// federated to. Federated users log in with tokens.CreateFederated, and exchange
+// the resulting unscoped token for a scoped one with tokens.Rescope.
+package federation
@@ -0,0 +1,5 @@
+// Package identityproviders provides information and interaction with the
+// identity providers of the OS-FEDERATION extension for the OpenStack Identity
+// service. An identity provider is an external source of identities, such as a
+// SAML IdP or an OpenID Connect provider, whose users may authenticate.
+package identityproviders
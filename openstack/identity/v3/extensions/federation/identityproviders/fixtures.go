@@ -0,0 +1,106 @@
+// +build fixtures
+
+package identityproviders
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/rackspace/gophercloud/testhelper"
+	fake "github.com/rackspace/gophercloud/testhelper/client"
+)
+
+// IdentityProviderOutput is an identity provider as the service returns it.
+const IdentityProviderOutput = `
+{
+	"identity_provider": {
+		"id": "corp-saml",
+		"description": "Corporate SAML IdP",
+		"domain_id": "7b6c41",
+		"enabled": true,
+		"remote_ids": ["https://idp.example.com/saml2/idp/metadata.php"],
+		"links": {
+			"protocols": "http://localhost:5000/v3/OS-FEDERATION/identity_providers/corp-saml/protocols",
+			"self": "http://localhost:5000/v3/OS-FEDERATION/identity_providers/corp-saml"
+		}
+	}
+}
+`
+
+// ListOutput is a page of identity providers as the service returns it.
+const ListOutput = `
+{
+	"links": {
+		"next": null,
+		"previous": null
+	},
+	"identity_providers": [
+		{
+			"id": "corp-saml",
+			"description": "Corporate SAML IdP",
+			"domain_id": "7b6c41",
+			"enabled": true,
+			"remote_ids": ["https://idp.example.com/saml2/idp/metadata.php"]
+		}
+	]
+}
+`
+
+// CorpSAML is the expected result of extracting IdentityProviderOutput.
+var CorpSAML = IdentityProvider{
+	ID:          "corp-saml",
+	Description: "Corporate SAML IdP",
+	DomainID:    "7b6c41",
+	Enabled:     true,
+	RemoteIDs:   []string{"https://idp.example.com/saml2/idp/metadata.php"},
+}
+
+// HandleListIdentityProvidersSuccessfully sets up the test server to respond to a List request.
+func HandleListIdentityProvidersSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-FEDERATION/identity_providers", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"enabled": "true"})
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, ListOutput)
+	})
+}
+
+// HandleIdentityProviderSuccessfully sets up the test server to respond to Get, Create, Update and
+// Delete requests for the corp-saml identity provider.
+func HandleIdentityProviderSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-FEDERATION/identity_providers/corp-saml", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		switch r.Method {
+		case "GET":
+		case "PUT":
+			th.TestJSONRequest(t, r, `
+				{
+					"identity_provider": {
+						"description": "Corporate SAML IdP",
+						"domain_id": "7b6c41",
+						"enabled": true,
+						"remote_ids": ["https://idp.example.com/saml2/idp/metadata.php"]
+					}
+				}
+			`)
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprintf(w, IdentityProviderOutput)
+			return
+		case "PATCH":
+			th.TestJSONRequest(t, r, `{ "identity_provider": { "enabled": true } }`)
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+			return
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, IdentityProviderOutput)
+	})
+}
@@ -0,0 +1,178 @@
+package identityproviders
+
+import (
+	"github.com/rackspace/gophercloud"
+	"github.com/rackspace/gophercloud/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the List request.
+type ListOptsBuilder interface {
+	ToIdentityProviderListQuery() (string, error)
+}
+
+// ListOpts allows you to filter the identity providers returned by the List method.
+type ListOpts struct {
+	// ID limits the results to the identity provider with the given ID.
+	ID string `q:"id"`
+
+	// Enabled limits the results to enabled or disabled identity providers.
+	Enabled *bool `q:"enabled"`
+}
+
+// ToIdentityProviderListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToIdentityProviderListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), nil
+}
+
+// List enumerates the registered identity providers.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(client)
+	if opts != nil {
+		query, err := opts.ToIdentityProviderListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+
+	createPage := func(r pagination.PageResult) pagination.Page {
+		return IdentityProviderPage{pagination.LinkedPageBase{PageResult: r}}
+	}
+
+	return pagination.NewPager(client, url, createPage)
+}
+
+// Get returns additional information about an identity provider, given its ID.
+func Get(client *gophercloud.ServiceClient, idpID string) GetResult {
+	var result GetResult
+	_, result.Err = client.Get(identityProviderURL(client, idpID), &result.Body, nil)
+	return result
+}
+
+// CreateOptsBuilder describes struct types that can be accepted by the Create call.
+type CreateOptsBuilder interface {
+	ToIdentityProviderCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts contains the attributes of a new identity provider.
+type CreateOpts struct {
+	// Description describes the identity provider.
+	Description string
+
+	// DomainID is the ID of the domain that the identity provider's users are created in. The
+	// service creates a new domain for them when it's empty.
+	DomainID string
+
+	// Enabled determines whether the identity provider's users may authenticate. Identity providers
+	// are disabled by default.
+	Enabled gophercloud.EnabledState
+
+	// RemoteIDs are the entity IDs by which the identity provider identifies itself, e.g. in the
+	// assertions it issues. They must be unique among all identity providers.
+	RemoteIDs []string
+}
+
+// ToIdentityProviderCreateMap assembles a request body based on the contents of a CreateOpts.
+func (opts CreateOpts) ToIdentityProviderCreateMap() (map[string]interface{}, error) {
+	idp := make(map[string]interface{})
+	if opts.Description != "" {
+		idp["description"] = opts.Description
+	}
+	if opts.DomainID != "" {
+		idp["domain_id"] = opts.DomainID
+	}
+	if opts.Enabled != nil {
+		idp["enabled"] = *opts.Enabled
+	}
+	if len(opts.RemoteIDs) > 0 {
+		idp["remote_ids"] = opts.RemoteIDs
+	}
+
+	return map[string]interface{}{"identity_provider": idp}, nil
+}
+
+// Create registers a new identity provider with the given ID.
+func Create(client *gophercloud.ServiceClient, idpID string, opts CreateOptsBuilder) CreateResult {
+	var result CreateResult
+
+	reqBody, err := opts.ToIdentityProviderCreateMap()
+	if err != nil {
+		result.Err = err
+		return result
+	}
+
+	_, result.Err = client.Put(identityProviderURL(client, idpID), reqBody, &result.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return result
+}
+
+// UpdateOptsBuilder allows extensions to add additional attributes to the Update request.
+type UpdateOptsBuilder interface {
+	ToIdentityProviderUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts contains the attributes of an identity provider that may be changed. Attributes left
+// empty are not changed. An identity provider can't be moved to a different domain.
+type UpdateOpts struct {
+	Description string
+	Enabled     gophercloud.EnabledState
+
+	// RemoteIDs replaces the identity provider's remote IDs. A non-nil, empty slice removes all of
+	// them.
+	RemoteIDs []string
+}
+
+// ToIdentityProviderUpdateMap assembles a request body based on the contents of an UpdateOpts.
+func (opts UpdateOpts) ToIdentityProviderUpdateMap() (map[string]interface{}, error) {
+	idp := make(map[string]interface{})
+	if opts.Description != "" {
+		idp["description"] = opts.Description
+	}
+	if opts.Enabled != nil {
+		idp["enabled"] = *opts.Enabled
+	}
+	if opts.RemoteIDs != nil {
+		idp["remote_ids"] = opts.RemoteIDs
+	}
+
+	return map[string]interface{}{"identity_provider": idp}, nil
+}
+
+// Update changes the attributes of an existing identity provider.
+func Update(client *gophercloud.ServiceClient, idpID string, opts UpdateOptsBuilder) UpdateResult {
+	var result UpdateResult
+
+	reqBody, err := opts.ToIdentityProviderUpdateMap()
+	if err != nil {
+		result.Err = err
+		return result
+	}
+
+	_, result.Err = client.Request("PATCH", identityProviderURL(client, idpID), gophercloud.RequestOpts{
+		JSONBody:     reqBody,
+		JSONResponse: &result.Body,
+		OkCodes:      []int{200},
+	})
+	return result
+}
+
+// Delete removes an identity provider, along with its protocols.
+func Delete(client *gophercloud.ServiceClient, idpID string) DeleteResult {
+	var result DeleteResult
+	_, result.Err = client.Delete(identityProviderURL(client, idpID), nil)
+	return result
+}
+
+// ListAll returns the identity providers that match opts.
+func ListAll(client *gophercloud.ServiceClient, opts ListOptsBuilder) ([]IdentityProvider, error) {
+	pages, err := List(client, opts).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	return ExtractIdentityProviders(pages)
+}
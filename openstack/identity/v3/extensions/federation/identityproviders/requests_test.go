@@ -0,0 +1,64 @@
+package identityproviders
+
+import (
+	"testing"
+
+	"github.com/rackspace/gophercloud"
+	th "github.com/rackspace/gophercloud/testhelper"
+	"github.com/rackspace/gophercloud/testhelper/client"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListIdentityProvidersSuccessfully(t)
+
+	enabled := true
+	actual, err := ListAll(client.ServiceClient(), ListOpts{Enabled: &enabled})
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []IdentityProvider{CorpSAML}, actual)
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleIdentityProviderSuccessfully(t)
+
+	actual, err := Get(client.ServiceClient(), "corp-saml").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, CorpSAML, *actual)
+}
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleIdentityProviderSuccessfully(t)
+
+	opts := CreateOpts{
+		Description: "Corporate SAML IdP",
+		DomainID:    "7b6c41",
+		Enabled:     gophercloud.Enabled,
+		RemoteIDs:   []string{"https://idp.example.com/saml2/idp/metadata.php"},
+	}
+	actual, err := Create(client.ServiceClient(), "corp-saml", opts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, CorpSAML, *actual)
+}
+
+func TestUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleIdentityProviderSuccessfully(t)
+
+	actual, err := Update(client.ServiceClient(), "corp-saml", UpdateOpts{Enabled: gophercloud.Enabled}).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, CorpSAML, *actual)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleIdentityProviderSuccessfully(t)
+
+	th.AssertNoErr(t, Delete(client.ServiceClient(), "corp-saml").ExtractErr())
+}
@@ -0,0 +1,96 @@
+package identityproviders
+
+import (
+	"github.com/rackspace/gophercloud"
+	"github.com/rackspace/gophercloud/pagination"
+
+	"github.com/mitchellh/mapstructure"
+)
+
+// IdentityProvider is an external source of identities.
+type IdentityProvider struct {
+	// ID is the unique ID of the identity provider, which is part of its users' login URL.
+	ID string `mapstructure:"id"`
+
+	// Description describes the identity provider.
+	Description string `mapstructure:"description"`
+
+	// DomainID is the ID of the domain that the identity provider's users are created in.
+	DomainID string `mapstructure:"domain_id"`
+
+	// Enabled is false if the identity provider has been disabled, in which case its users can't
+	// authenticate.
+	Enabled bool `mapstructure:"enabled"`
+
+	// RemoteIDs are the entity IDs by which the identity provider identifies itself.
+	RemoteIDs []string `mapstructure:"remote_ids"`
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a GetResult, CreateResult or UpdateResult as a concrete IdentityProvider.
+// An error is returned if the original call or the extraction failed.
+func (r commonResult) Extract() (*IdentityProvider, error) {
+	if r.Err != nil {
+		return nil, r.Err
+	}
+
+	var res struct {
+		IdentityProvider IdentityProvider `mapstructure:"identity_provider"`
+	}
+
+	err := mapstructure.Decode(r.Body, &res)
+
+	return &res.IdentityProvider, err
+}
+
+// CreateResult is the deferred result of a Create call.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult is the deferred result of a Get call.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult is the deferred result of an Update call.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult is the deferred result of a Delete call.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// IdentityProviderPage is a single page of IdentityProvider results.
+type IdentityProviderPage struct {
+	pagination.LinkedPageBase
+}
+
+// CollectionKey returns "identity_providers".
+func (page IdentityProviderPage) CollectionKey() string {
+	return "identity_providers"
+}
+
+// IsEmpty returns true if the page contains no results.
+func (page IdentityProviderPage) IsEmpty() (bool, error) {
+	idps, err := ExtractIdentityProviders(page)
+	if err != nil {
+		return true, err
+	}
+	return len(idps) == 0, nil
+}
+
+// ExtractIdentityProviders extracts a slice of IdentityProviders from a page acquired from List.
+func ExtractIdentityProviders(page pagination.Page) ([]IdentityProvider, error) {
+	var response struct {
+		IdentityProviders []IdentityProvider `mapstructure:"identity_providers"`
+	}
+
+	err := mapstructure.Decode(page.(IdentityProviderPage).Body, &response)
+	return response.IdentityProviders, err
+}
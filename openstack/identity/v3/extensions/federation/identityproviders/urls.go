@@ -0,0 +1,11 @@
+package identityproviders
+
+import "github.com/rackspace/gophercloud"
+
+func listURL(client *gophercloud.ServiceClient) string {
+	return client.ServiceURL("OS-FEDERATION", "identity_providers")
+}
+
+func identityProviderURL(client *gophercloud.ServiceClient, idpID string) string {
+	return client.ServiceURL("OS-FEDERATION", "identity_providers", idpID)
+}
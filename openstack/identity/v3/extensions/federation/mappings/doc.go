@@ -0,0 +1,19 @@
+// Package mappings provides information and interaction with the mappings of
+// the OS-FEDERATION extension for the OpenStack Identity service. A mapping is
+// a list of rules that match the attributes of a federated user's assertion
+// and turn them into a local user, groups and projects:
+//
+//   rules := []mappings.MappingRule{
+//     {
+//       Remote: []mappings.RemoteRule{
+//         {Type: "REMOTE_USER"},
+//         {Type: "orgPersonType", AnyOneOf: []string{"Employee"}},
+//       },
+//       Local: []mappings.LocalRule{
+//         {User: &mappings.RuleUser{Name: "{0}"}},
+//         {Group: &mappings.RuleGroup{ID: "0cd5e9"}},
+//       },
+//     },
+//   }
+//   mapping, err := mappings.Create(client, "corp-mapping", mappings.CreateOpts{Rules: rules}).Extract()
+package mappings
@@ -0,0 +1,114 @@
+// +build fixtures
+
+package mappings
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/rackspace/gophercloud/testhelper"
+	fake "github.com/rackspace/gophercloud/testhelper/client"
+)
+
+// RulesJSON are the rules of the corp-mapping mapping, as they're sent and returned.
+const RulesJSON = `
+[
+	{
+		"local": [
+			{
+				"user": {
+					"name": "{0}",
+					"email": "{1}"
+				}
+			},
+			{
+				"groups": "{2}",
+				"domain": {
+					"name": "corp"
+				}
+			},
+			{
+				"projects": [
+					{
+						"name": "{0}-sandbox",
+						"roles": [{"name": "member"}]
+					}
+				]
+			}
+		],
+		"remote": [
+			{
+				"type": "REMOTE_USER"
+			},
+			{
+				"type": "mail"
+			},
+			{
+				"type": "memberOf",
+				"whitelist": ["developers", "operators"]
+			},
+			{
+				"type": "orgPersonType",
+				"any_one_of": [".*Contractor.*"],
+				"regex": true
+			}
+		]
+	}
+]
+`
+
+// CorpRules are the expected result of extracting RulesJSON.
+var CorpRules = []MappingRule{
+	{
+		Local: []LocalRule{
+			{User: &RuleUser{Name: "{0}", Email: "{1}"}},
+			{Groups: "{2}", Domain: &RuleDomain{Name: "corp"}},
+			{Projects: []RuleProject{{Name: "{0}-sandbox", Roles: []RuleRole{{Name: "member"}}}}},
+		},
+		Remote: []RemoteRule{
+			{Type: "REMOTE_USER"},
+			{Type: "mail"},
+			{Type: "memberOf", Whitelist: []string{"developers", "operators"}},
+			{Type: "orgPersonType", AnyOneOf: []string{".*Contractor.*"}, Regex: true},
+		},
+	},
+}
+
+// HandleListMappingsSuccessfully sets up the test server to respond to a List request.
+func HandleListMappingsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-FEDERATION/mappings", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"links": {"next": null}, "mappings": [{"id": "corp-mapping", "rules": %s}]}`, RulesJSON)
+	})
+}
+
+// HandleMappingSuccessfully sets up the test server to respond to Get, Create, Update and Delete
+// requests for the corp-mapping mapping.
+func HandleMappingSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-FEDERATION/mappings/corp-mapping", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		status := http.StatusOK
+		switch r.Method {
+		case "GET":
+		case "PUT":
+			th.TestJSONRequest(t, r, fmt.Sprintf(`{"mapping": {"rules": %s}}`, RulesJSON))
+			status = http.StatusCreated
+		case "PATCH":
+			th.TestJSONRequest(t, r, fmt.Sprintf(`{"mapping": {"rules": %s}}`, RulesJSON))
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+			return
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(status)
+		fmt.Fprintf(w, `{"mapping": {"id": "corp-mapping", "rules": %s}}`, RulesJSON)
+	})
+}
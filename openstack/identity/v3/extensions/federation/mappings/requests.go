@@ -0,0 +1,111 @@
+package mappings
+
+import (
+	"errors"
+
+	"github.com/rackspace/gophercloud"
+	"github.com/rackspace/gophercloud/pagination"
+)
+
+// List enumerates the mappings.
+func List(client *gophercloud.ServiceClient) pagination.Pager {
+	createPage := func(r pagination.PageResult) pagination.Page {
+		return MappingPage{pagination.LinkedPageBase{PageResult: r}}
+	}
+
+	return pagination.NewPager(client, listURL(client), createPage)
+}
+
+// Get returns a mapping and its rules, given its ID.
+func Get(client *gophercloud.ServiceClient, mappingID string) GetResult {
+	var result GetResult
+	_, result.Err = client.Get(mappingURL(client, mappingID), &result.Body, nil)
+	return result
+}
+
+// CreateOptsBuilder describes struct types that can be accepted by the Create call.
+type CreateOptsBuilder interface {
+	ToMappingCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts contains the rules of a new mapping. At least one rule is required.
+type CreateOpts struct {
+	Rules []MappingRule
+}
+
+// ToMappingCreateMap assembles a request body based on the contents of a CreateOpts.
+func (opts CreateOpts) ToMappingCreateMap() (map[string]interface{}, error) {
+	if len(opts.Rules) == 0 {
+		return nil, errors.New("At least one Rule must be provided")
+	}
+
+	return map[string]interface{}{
+		"mapping": map[string]interface{}{"rules": opts.Rules},
+	}, nil
+}
+
+// Create adds a new mapping with the given ID.
+func Create(client *gophercloud.ServiceClient, mappingID string, opts CreateOptsBuilder) CreateResult {
+	var result CreateResult
+
+	reqBody, err := opts.ToMappingCreateMap()
+	if err != nil {
+		result.Err = err
+		return result
+	}
+
+	_, result.Err = client.Put(mappingURL(client, mappingID), reqBody, &result.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return result
+}
+
+// UpdateOptsBuilder allows extensions to add additional attributes to the Update request.
+type UpdateOptsBuilder interface {
+	ToMappingUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts contains the rules that replace those of an existing mapping. At least one rule is
+// required.
+type UpdateOpts struct {
+	Rules []MappingRule
+}
+
+// ToMappingUpdateMap assembles a request body based on the contents of an UpdateOpts.
+func (opts UpdateOpts) ToMappingUpdateMap() (map[string]interface{}, error) {
+	return CreateOpts(opts).ToMappingCreateMap()
+}
+
+// Update replaces the rules of an existing mapping.
+func Update(client *gophercloud.ServiceClient, mappingID string, opts UpdateOptsBuilder) UpdateResult {
+	var result UpdateResult
+
+	reqBody, err := opts.ToMappingUpdateMap()
+	if err != nil {
+		result.Err = err
+		return result
+	}
+
+	_, result.Err = client.Request("PATCH", mappingURL(client, mappingID), gophercloud.RequestOpts{
+		JSONBody:     reqBody,
+		JSONResponse: &result.Body,
+		OkCodes:      []int{200},
+	})
+	return result
+}
+
+// Delete removes a mapping. It fails while a protocol still uses the mapping.
+func Delete(client *gophercloud.ServiceClient, mappingID string) DeleteResult {
+	var result DeleteResult
+	_, result.Err = client.Delete(mappingURL(client, mappingID), nil)
+	return result
+}
+
+// ListAll returns every mapping.
+func ListAll(client *gophercloud.ServiceClient) ([]Mapping, error) {
+	pages, err := List(client).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	return ExtractMappings(pages)
+}
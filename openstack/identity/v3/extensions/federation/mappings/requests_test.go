@@ -0,0 +1,63 @@
+package mappings
+
+import (
+	"testing"
+
+	th "github.com/rackspace/gophercloud/testhelper"
+	"github.com/rackspace/gophercloud/testhelper/client"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListMappingsSuccessfully(t)
+
+	actual, err := ListAll(client.ServiceClient())
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []Mapping{{ID: "corp-mapping", Rules: CorpRules}}, actual)
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleMappingSuccessfully(t)
+
+	actual, err := Get(client.ServiceClient(), "corp-mapping").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, Mapping{ID: "corp-mapping", Rules: CorpRules}, *actual)
+}
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleMappingSuccessfully(t)
+
+	actual, err := Create(client.ServiceClient(), "corp-mapping", CreateOpts{Rules: CorpRules}).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, Mapping{ID: "corp-mapping", Rules: CorpRules}, *actual)
+}
+
+func TestCreateRequiresRules(t *testing.T) {
+	res := Create(client.ServiceClient(), "corp-mapping", CreateOpts{})
+	if res.Err == nil {
+		t.Fatalf("Expected an error for a mapping without rules")
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleMappingSuccessfully(t)
+
+	actual, err := Update(client.ServiceClient(), "corp-mapping", UpdateOpts{Rules: CorpRules}).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, Mapping{ID: "corp-mapping", Rules: CorpRules}, *actual)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleMappingSuccessfully(t)
+
+	th.AssertNoErr(t, Delete(client.ServiceClient(), "corp-mapping").ExtractErr())
+}
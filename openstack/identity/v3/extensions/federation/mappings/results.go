@@ -0,0 +1,174 @@
+package mappings
+
+import (
+	"github.com/rackspace/gophercloud"
+	"github.com/rackspace/gophercloud/pagination"
+
+	"github.com/mitchellh/mapstructure"
+)
+
+// Mapping turns the assertions of federated users into local users, groups and projects.
+type Mapping struct {
+	// ID is the unique ID of the mapping.
+	ID string `mapstructure:"id"`
+
+	// Rules are the mapping's rules, which are applied in order.
+	Rules []MappingRule `mapstructure:"rules"`
+}
+
+// MappingRule maps the assertions that match all of its Remote rules onto its Local rules.
+type MappingRule struct {
+	Local  []LocalRule  `json:"local" mapstructure:"local"`
+	Remote []RemoteRule `json:"remote" mapstructure:"remote"`
+}
+
+// RemoteRule matches an attribute of an assertion. Without any conditions it matches any
+// assertion with the attribute, whose value can then be referred to in the LocalRules as {0},
+// {1}, etc. in the order of the RemoteRules.
+type RemoteRule struct {
+	// Type is the name of the attribute, e.g. "REMOTE_USER" or "openstack_groups".
+	Type string `json:"type" mapstructure:"type"`
+
+	// AnyOneOf matches if the attribute has any of the values, and NotAnyOf if it has none of them.
+	AnyOneOf []string `json:"any_one_of,omitempty" mapstructure:"any_one_of"`
+	NotAnyOf []string `json:"not_any_of,omitempty" mapstructure:"not_any_of"`
+
+	// Regex treats the values of AnyOneOf and NotAnyOf as regular expressions.
+	Regex bool `json:"regex,omitempty" mapstructure:"regex"`
+
+	// Whitelist and Blacklist filter the values of a multi-valued attribute, such as a list of
+	// groups, before they're used in the LocalRules.
+	Whitelist []string `json:"whitelist,omitempty" mapstructure:"whitelist"`
+	Blacklist []string `json:"blacklist,omitempty" mapstructure:"blacklist"`
+}
+
+// LocalRule is one of the local entities that matching assertions map onto. Each LocalRule
+// usually sets just one of its fields.
+type LocalRule struct {
+	// User is the local user that the federated user becomes.
+	User *RuleUser `json:"user,omitempty" mapstructure:"user"`
+
+	// Group is a group that the user is made a member of.
+	Group *RuleGroup `json:"group,omitempty" mapstructure:"group"`
+
+	// Groups is a reference to an attribute that lists the names of groups that the user is made a
+	// member of, e.g. "{1}". They're looked up in Domain.
+	Groups string `json:"groups,omitempty" mapstructure:"groups"`
+
+	// GroupIDs is a reference to an attribute that lists the IDs of groups that the user is made a
+	// member of.
+	GroupIDs string `json:"group_ids,omitempty" mapstructure:"group_ids"`
+
+	// Domain is the domain in which the Groups are looked up.
+	Domain *RuleDomain `json:"domain,omitempty" mapstructure:"domain"`
+
+	// Projects are projects that the user is given roles on, which are created if they don't exist.
+	Projects []RuleProject `json:"projects,omitempty" mapstructure:"projects"`
+}
+
+// RuleUser identifies the local user of a LocalRule.
+type RuleUser struct {
+	ID    string `json:"id,omitempty" mapstructure:"id"`
+	Name  string `json:"name,omitempty" mapstructure:"name"`
+	Email string `json:"email,omitempty" mapstructure:"email"`
+
+	// Type is "ephemeral", the default, for a user that only exists while they hold a token, or
+	// "local" for an existing local user.
+	Type string `json:"type,omitempty" mapstructure:"type"`
+
+	// Domain is the domain of the user, which defaults to the identity provider's domain.
+	Domain *RuleDomain `json:"domain,omitempty" mapstructure:"domain"`
+}
+
+// RuleGroup identifies a local group, either by ID or by name within a domain.
+type RuleGroup struct {
+	ID     string      `json:"id,omitempty" mapstructure:"id"`
+	Name   string      `json:"name,omitempty" mapstructure:"name"`
+	Domain *RuleDomain `json:"domain,omitempty" mapstructure:"domain"`
+}
+
+// RuleDomain identifies a local domain by ID or by name.
+type RuleDomain struct {
+	ID   string `json:"id,omitempty" mapstructure:"id"`
+	Name string `json:"name,omitempty" mapstructure:"name"`
+}
+
+// RuleProject is a project that a federated user is given roles on.
+type RuleProject struct {
+	Name  string     `json:"name" mapstructure:"name"`
+	Roles []RuleRole `json:"roles" mapstructure:"roles"`
+}
+
+// RuleRole names a role that a federated user is given on a RuleProject.
+type RuleRole struct {
+	Name string `json:"name" mapstructure:"name"`
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a GetResult, CreateResult or UpdateResult as a concrete Mapping.
+// An error is returned if the original call or the extraction failed.
+func (r commonResult) Extract() (*Mapping, error) {
+	if r.Err != nil {
+		return nil, r.Err
+	}
+
+	var res struct {
+		Mapping Mapping `mapstructure:"mapping"`
+	}
+
+	err := mapstructure.Decode(r.Body, &res)
+
+	return &res.Mapping, err
+}
+
+// CreateResult is the deferred result of a Create call.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult is the deferred result of a Get call.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult is the deferred result of an Update call.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult is the deferred result of a Delete call.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// MappingPage is a single page of Mapping results.
+type MappingPage struct {
+	pagination.LinkedPageBase
+}
+
+// CollectionKey returns "mappings".
+func (page MappingPage) CollectionKey() string {
+	return "mappings"
+}
+
+// IsEmpty returns true if the page contains no results.
+func (page MappingPage) IsEmpty() (bool, error) {
+	mappings, err := ExtractMappings(page)
+	if err != nil {
+		return true, err
+	}
+	return len(mappings) == 0, nil
+}
+
+// ExtractMappings extracts a slice of Mappings from a page acquired from List.
+func ExtractMappings(page pagination.Page) ([]Mapping, error) {
+	var response struct {
+		Mappings []Mapping `mapstructure:"mappings"`
+	}
+
+	err := mapstructure.Decode(page.(MappingPage).Body, &response)
+	return response.Mappings, err
+}
@@ -0,0 +1,11 @@
+package mappings
+
+import "github.com/rackspace/gophercloud"
+
+func listURL(client *gophercloud.ServiceClient) string {
+	return client.ServiceURL("OS-FEDERATION", "mappings")
+}
+
+func mappingURL(client *gophercloud.ServiceClient, mappingID string) string {
+	return client.ServiceURL("OS-FEDERATION", "mappings", mappingID)
+}
@@ -0,0 +1,6 @@
+// Package protocols provides information and interaction with the protocols of
+// the identity providers of the OS-FEDERATION extension for the OpenStack
+// Identity service. A protocol, such as "saml2" or "openid", names the way
+// that an identity provider's users authenticate, and the mapping that turns
+// their assertions into local users and groups.
+package protocols
@@ -0,0 +1,94 @@
+// +build fixtures
+
+package protocols
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/rackspace/gophercloud/testhelper"
+	fake "github.com/rackspace/gophercloud/testhelper/client"
+)
+
+// ProtocolOutput is a protocol as the service returns it.
+const ProtocolOutput = `
+{
+	"protocol": {
+		"id": "saml2",
+		"mapping_id": "corp-mapping",
+		"remote_id_attribute": "Shib-Identity-Provider",
+		"links": {
+			"identity_provider": "http://localhost:5000/v3/OS-FEDERATION/identity_providers/corp-saml",
+			"self": "http://localhost:5000/v3/OS-FEDERATION/identity_providers/corp-saml/protocols/saml2"
+		}
+	}
+}
+`
+
+// SAML2 is the expected result of extracting ProtocolOutput.
+var SAML2 = Protocol{
+	ID:                "saml2",
+	MappingID:         "corp-mapping",
+	RemoteIDAttribute: "Shib-Identity-Provider",
+}
+
+// HandleListProtocolsSuccessfully sets up the test server to respond to a List request.
+func HandleListProtocolsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-FEDERATION/identity_providers/corp-saml/protocols", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `
+			{
+				"links": {
+					"next": null,
+					"previous": null
+				},
+				"protocols": [
+					{
+						"id": "saml2",
+						"mapping_id": "corp-mapping",
+						"remote_id_attribute": "Shib-Identity-Provider"
+					}
+				]
+			}
+		`)
+	})
+}
+
+// HandleProtocolSuccessfully sets up the test server to respond to Get, Create, Update and Delete
+// requests for the saml2 protocol of the corp-saml identity provider.
+func HandleProtocolSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-FEDERATION/identity_providers/corp-saml/protocols/saml2", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		switch r.Method {
+		case "GET":
+		case "PUT":
+			th.TestJSONRequest(t, r, `
+				{
+					"protocol": {
+						"mapping_id": "corp-mapping",
+						"remote_id_attribute": "Shib-Identity-Provider"
+					}
+				}
+			`)
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprintf(w, ProtocolOutput)
+			return
+		case "PATCH":
+			th.TestJSONRequest(t, r, `{ "protocol": { "mapping_id": "corp-mapping" } }`)
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+			return
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, ProtocolOutput)
+	})
+}
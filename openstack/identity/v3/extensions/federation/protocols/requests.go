@@ -0,0 +1,129 @@
+package protocols
+
+import (
+	"errors"
+
+	"github.com/rackspace/gophercloud"
+	"github.com/rackspace/gophercloud/pagination"
+)
+
+// List enumerates the protocols of an identity provider.
+func List(client *gophercloud.ServiceClient, idpID string) pagination.Pager {
+	createPage := func(r pagination.PageResult) pagination.Page {
+		return ProtocolPage{pagination.LinkedPageBase{PageResult: r}}
+	}
+
+	return pagination.NewPager(client, listURL(client, idpID), createPage)
+}
+
+// Get returns additional information about a protocol of an identity provider.
+func Get(client *gophercloud.ServiceClient, idpID, protocolID string) GetResult {
+	var result GetResult
+	_, result.Err = client.Get(protocolURL(client, idpID, protocolID), &result.Body, nil)
+	return result
+}
+
+// CreateOptsBuilder describes struct types that can be accepted by the Create call.
+type CreateOptsBuilder interface {
+	ToProtocolCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts contains the attributes of a new protocol.
+type CreateOpts struct {
+	// MappingID is the ID of the mapping that turns the assertions of the identity provider's users
+	// into local users and groups. It's required.
+	MappingID string
+
+	// RemoteIDAttribute is the attribute of an assertion that holds the identity provider's remote
+	// ID. The service's configured default is used when it's empty.
+	RemoteIDAttribute string
+}
+
+// ToProtocolCreateMap assembles a request body based on the contents of a CreateOpts.
+func (opts CreateOpts) ToProtocolCreateMap() (map[string]interface{}, error) {
+	if opts.MappingID == "" {
+		return nil, errors.New("A MappingID must be provided")
+	}
+
+	p := map[string]interface{}{"mapping_id": opts.MappingID}
+	if opts.RemoteIDAttribute != "" {
+		p["remote_id_attribute"] = opts.RemoteIDAttribute
+	}
+
+	return map[string]interface{}{"protocol": p}, nil
+}
+
+// Create adds a protocol with the given ID, such as "saml2" or "openid", to an identity provider.
+func Create(client *gophercloud.ServiceClient, idpID, protocolID string, opts CreateOptsBuilder) CreateResult {
+	var result CreateResult
+
+	reqBody, err := opts.ToProtocolCreateMap()
+	if err != nil {
+		result.Err = err
+		return result
+	}
+
+	_, result.Err = client.Put(protocolURL(client, idpID, protocolID), reqBody, &result.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return result
+}
+
+// UpdateOptsBuilder allows extensions to add additional attributes to the Update request.
+type UpdateOptsBuilder interface {
+	ToProtocolUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts contains the attributes of a protocol that may be changed. Attributes left empty are
+// not changed.
+type UpdateOpts struct {
+	MappingID         string
+	RemoteIDAttribute string
+}
+
+// ToProtocolUpdateMap assembles a request body based on the contents of an UpdateOpts.
+func (opts UpdateOpts) ToProtocolUpdateMap() (map[string]interface{}, error) {
+	p := make(map[string]interface{})
+	if opts.MappingID != "" {
+		p["mapping_id"] = opts.MappingID
+	}
+	if opts.RemoteIDAttribute != "" {
+		p["remote_id_attribute"] = opts.RemoteIDAttribute
+	}
+
+	return map[string]interface{}{"protocol": p}, nil
+}
+
+// Update changes the attributes of an existing protocol.
+func Update(client *gophercloud.ServiceClient, idpID, protocolID string, opts UpdateOptsBuilder) UpdateResult {
+	var result UpdateResult
+
+	reqBody, err := opts.ToProtocolUpdateMap()
+	if err != nil {
+		result.Err = err
+		return result
+	}
+
+	_, result.Err = client.Request("PATCH", protocolURL(client, idpID, protocolID), gophercloud.RequestOpts{
+		JSONBody:     reqBody,
+		JSONResponse: &result.Body,
+		OkCodes:      []int{200},
+	})
+	return result
+}
+
+// Delete removes a protocol from an identity provider.
+func Delete(client *gophercloud.ServiceClient, idpID, protocolID string) DeleteResult {
+	var result DeleteResult
+	_, result.Err = client.Delete(protocolURL(client, idpID, protocolID), nil)
+	return result
+}
+
+// ListAll returns every protocol of the identity provider idpID.
+func ListAll(client *gophercloud.ServiceClient, idpID string) ([]Protocol, error) {
+	pages, err := List(client, idpID).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	return ExtractProtocols(pages)
+}
@@ -0,0 +1,64 @@
+package protocols
+
+import (
+	"testing"
+
+	th "github.com/rackspace/gophercloud/testhelper"
+	"github.com/rackspace/gophercloud/testhelper/client"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListProtocolsSuccessfully(t)
+
+	actual, err := ListAll(client.ServiceClient(), "corp-saml")
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []Protocol{SAML2}, actual)
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleProtocolSuccessfully(t)
+
+	actual, err := Get(client.ServiceClient(), "corp-saml", "saml2").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, SAML2, *actual)
+}
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleProtocolSuccessfully(t)
+
+	opts := CreateOpts{MappingID: "corp-mapping", RemoteIDAttribute: "Shib-Identity-Provider"}
+	actual, err := Create(client.ServiceClient(), "corp-saml", "saml2", opts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, SAML2, *actual)
+}
+
+func TestCreateRequiresMapping(t *testing.T) {
+	res := Create(client.ServiceClient(), "corp-saml", "saml2", CreateOpts{})
+	if res.Err == nil {
+		t.Fatalf("Expected an error for a protocol without a mapping")
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleProtocolSuccessfully(t)
+
+	actual, err := Update(client.ServiceClient(), "corp-saml", "saml2", UpdateOpts{MappingID: "corp-mapping"}).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, SAML2, *actual)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleProtocolSuccessfully(t)
+
+	th.AssertNoErr(t, Delete(client.ServiceClient(), "corp-saml", "saml2").ExtractErr())
+}
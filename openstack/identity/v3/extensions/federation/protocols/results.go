@@ -0,0 +1,91 @@
+package protocols
+
+import (
+	"github.com/rackspace/gophercloud"
+	"github.com/rackspace/gophercloud/pagination"
+
+	"github.com/mitchellh/mapstructure"
+)
+
+// Protocol is a way in which an identity provider's users authenticate.
+type Protocol struct {
+	// ID is the name of the protocol, such as "saml2" or "openid", which is part of its users'
+	// login URL.
+	ID string `mapstructure:"id"`
+
+	// MappingID is the ID of the mapping that turns assertions into local users and groups.
+	MappingID string `mapstructure:"mapping_id"`
+
+	// RemoteIDAttribute is the attribute of an assertion that holds the identity provider's remote
+	// ID.
+	RemoteIDAttribute string `mapstructure:"remote_id_attribute"`
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a GetResult, CreateResult or UpdateResult as a concrete Protocol.
+// An error is returned if the original call or the extraction failed.
+func (r commonResult) Extract() (*Protocol, error) {
+	if r.Err != nil {
+		return nil, r.Err
+	}
+
+	var res struct {
+		Protocol Protocol `mapstructure:"protocol"`
+	}
+
+	err := mapstructure.Decode(r.Body, &res)
+
+	return &res.Protocol, err
+}
+
+// CreateResult is the deferred result of a Create call.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult is the deferred result of a Get call.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult is the deferred result of an Update call.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult is the deferred result of a Delete call.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// ProtocolPage is a single page of Protocol results.
+type ProtocolPage struct {
+	pagination.LinkedPageBase
+}
+
+// CollectionKey returns "protocols".
+func (page ProtocolPage) CollectionKey() string {
+	return "protocols"
+}
+
+// IsEmpty returns true if the page contains no results.
+func (page ProtocolPage) IsEmpty() (bool, error) {
+	protocols, err := ExtractProtocols(page)
+	if err != nil {
+		return true, err
+	}
+	return len(protocols) == 0, nil
+}
+
+// ExtractProtocols extracts a slice of Protocols from a page acquired from List.
+func ExtractProtocols(page pagination.Page) ([]Protocol, error) {
+	var response struct {
+		Protocols []Protocol `mapstructure:"protocols"`
+	}
+
+	err := mapstructure.Decode(page.(ProtocolPage).Body, &response)
+	return response.Protocols, err
+}
@@ -0,0 +1,5 @@
+// Package serviceproviders provides information and interaction with the
+// service providers of the OS-FEDERATION extension for the OpenStack Identity
+// service. A service provider is a remote cloud that trusts this one as an
+// identity provider, so that local users can use it with federated tokens.
+package serviceproviders
@@ -0,0 +1,104 @@
+// +build fixtures
+
+package serviceproviders
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/rackspace/gophercloud/testhelper"
+	fake "github.com/rackspace/gophercloud/testhelper/client"
+)
+
+// ServiceProviderOutput is a service provider as the service returns it.
+const ServiceProviderOutput = `
+{
+	"service_provider": {
+		"id": "partner-cloud",
+		"auth_url": "https://partner.example.com:5000/v3/OS-FEDERATION/identity_providers/acme/protocols/saml2/auth",
+		"sp_url": "https://partner.example.com:5000/Shibboleth.sso/SAML2/ECP",
+		"description": "Partner cloud",
+		"enabled": true,
+		"relay_state_prefix": "ss:mem:",
+		"links": {
+			"self": "http://localhost:5000/v3/OS-FEDERATION/service_providers/partner-cloud"
+		}
+	}
+}
+`
+
+// PartnerCloud is the expected result of extracting ServiceProviderOutput.
+var PartnerCloud = ServiceProvider{
+	ID:               "partner-cloud",
+	AuthURL:          "https://partner.example.com:5000/v3/OS-FEDERATION/identity_providers/acme/protocols/saml2/auth",
+	SPURL:            "https://partner.example.com:5000/Shibboleth.sso/SAML2/ECP",
+	Description:      "Partner cloud",
+	Enabled:          true,
+	RelayStatePrefix: "ss:mem:",
+}
+
+// HandleListServiceProvidersSuccessfully sets up the test server to respond to a List request.
+func HandleListServiceProvidersSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-FEDERATION/service_providers", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `
+			{
+				"links": {
+					"next": null,
+					"previous": null
+				},
+				"service_providers": [
+					{
+						"id": "partner-cloud",
+						"auth_url": "https://partner.example.com:5000/v3/OS-FEDERATION/identity_providers/acme/protocols/saml2/auth",
+						"sp_url": "https://partner.example.com:5000/Shibboleth.sso/SAML2/ECP",
+						"description": "Partner cloud",
+						"enabled": true,
+						"relay_state_prefix": "ss:mem:"
+					}
+				]
+			}
+		`)
+	})
+}
+
+// HandleServiceProviderSuccessfully sets up the test server to respond to Get, Create, Update and
+// Delete requests for the partner-cloud service provider.
+func HandleServiceProviderSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-FEDERATION/service_providers/partner-cloud", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		status := http.StatusOK
+		switch r.Method {
+		case "GET":
+		case "PUT":
+			th.TestJSONRequest(t, r, `
+				{
+					"service_provider": {
+						"auth_url": "https://partner.example.com:5000/v3/OS-FEDERATION/identity_providers/acme/protocols/saml2/auth",
+						"sp_url": "https://partner.example.com:5000/Shibboleth.sso/SAML2/ECP",
+						"description": "Partner cloud",
+						"enabled": true,
+						"relay_state_prefix": "ss:mem:"
+					}
+				}
+			`)
+			status = http.StatusCreated
+		case "PATCH":
+			th.TestJSONRequest(t, r, `{ "service_provider": { "description": "Partner cloud" } }`)
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+			return
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(status)
+		fmt.Fprintf(w, ServiceProviderOutput)
+	})
+}
@@ -0,0 +1,163 @@
+package serviceproviders
+
+import (
+	"errors"
+
+	"github.com/rackspace/gophercloud"
+	"github.com/rackspace/gophercloud/pagination"
+)
+
+// List enumerates the registered service providers.
+func List(client *gophercloud.ServiceClient) pagination.Pager {
+	createPage := func(r pagination.PageResult) pagination.Page {
+		return ServiceProviderPage{pagination.LinkedPageBase{PageResult: r}}
+	}
+
+	return pagination.NewPager(client, listURL(client), createPage)
+}
+
+// Get returns additional information about a service provider, given its ID.
+func Get(client *gophercloud.ServiceClient, spID string) GetResult {
+	var result GetResult
+	_, result.Err = client.Get(serviceProviderURL(client, spID), &result.Body, nil)
+	return result
+}
+
+// CreateOptsBuilder describes struct types that can be accepted by the Create call.
+type CreateOptsBuilder interface {
+	ToServiceProviderCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts contains the attributes of a new service provider.
+type CreateOpts struct {
+	// AuthURL is the URL at which the service provider accepts federated assertions, i.e. the auth
+	// URL of this cloud's identity provider and protocol on the service provider. It's required.
+	AuthURL string
+
+	// SPURL is the URL to which SAML assertions are posted, i.e. the service provider's ECP
+	// endpoint. It's required.
+	SPURL string
+
+	// Description describes the service provider.
+	Description string
+
+	// Enabled determines whether tokens may be federated to the service provider. Service providers
+	// are disabled by default.
+	Enabled gophercloud.EnabledState
+
+	// RelayStatePrefix overrides the prefix of the RelayState of the SAML ECP wrapper.
+	RelayStatePrefix string
+}
+
+// ToServiceProviderCreateMap assembles a request body based on the contents of a CreateOpts.
+func (opts CreateOpts) ToServiceProviderCreateMap() (map[string]interface{}, error) {
+	if opts.AuthURL == "" {
+		return nil, errors.New("An AuthURL must be provided")
+	}
+	if opts.SPURL == "" {
+		return nil, errors.New("An SPURL must be provided")
+	}
+
+	sp := map[string]interface{}{
+		"auth_url": opts.AuthURL,
+		"sp_url":   opts.SPURL,
+	}
+	if opts.Description != "" {
+		sp["description"] = opts.Description
+	}
+	if opts.Enabled != nil {
+		sp["enabled"] = *opts.Enabled
+	}
+	if opts.RelayStatePrefix != "" {
+		sp["relay_state_prefix"] = opts.RelayStatePrefix
+	}
+
+	return map[string]interface{}{"service_provider": sp}, nil
+}
+
+// Create registers a new service provider with the given ID.
+func Create(client *gophercloud.ServiceClient, spID string, opts CreateOptsBuilder) CreateResult {
+	var result CreateResult
+
+	reqBody, err := opts.ToServiceProviderCreateMap()
+	if err != nil {
+		result.Err = err
+		return result
+	}
+
+	_, result.Err = client.Put(serviceProviderURL(client, spID), reqBody, &result.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return result
+}
+
+// UpdateOptsBuilder allows extensions to add additional attributes to the Update request.
+type UpdateOptsBuilder interface {
+	ToServiceProviderUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts contains the attributes of a service provider that may be changed. Attributes left
+// empty are not changed.
+type UpdateOpts struct {
+	AuthURL          string
+	SPURL            string
+	Description      string
+	Enabled          gophercloud.EnabledState
+	RelayStatePrefix string
+}
+
+// ToServiceProviderUpdateMap assembles a request body based on the contents of an UpdateOpts.
+func (opts UpdateOpts) ToServiceProviderUpdateMap() (map[string]interface{}, error) {
+	sp := make(map[string]interface{})
+	if opts.AuthURL != "" {
+		sp["auth_url"] = opts.AuthURL
+	}
+	if opts.SPURL != "" {
+		sp["sp_url"] = opts.SPURL
+	}
+	if opts.Description != "" {
+		sp["description"] = opts.Description
+	}
+	if opts.Enabled != nil {
+		sp["enabled"] = *opts.Enabled
+	}
+	if opts.RelayStatePrefix != "" {
+		sp["relay_state_prefix"] = opts.RelayStatePrefix
+	}
+
+	return map[string]interface{}{"service_provider": sp}, nil
+}
+
+// Update changes the attributes of an existing service provider.
+func Update(client *gophercloud.ServiceClient, spID string, opts UpdateOptsBuilder) UpdateResult {
+	var result UpdateResult
+
+	reqBody, err := opts.ToServiceProviderUpdateMap()
+	if err != nil {
+		result.Err = err
+		return result
+	}
+
+	_, result.Err = client.Request("PATCH", serviceProviderURL(client, spID), gophercloud.RequestOpts{
+		JSONBody:     reqBody,
+		JSONResponse: &result.Body,
+		OkCodes:      []int{200},
+	})
+	return result
+}
+
+// Delete removes a service provider.
+func Delete(client *gophercloud.ServiceClient, spID string) DeleteResult {
+	var result DeleteResult
+	_, result.Err = client.Delete(serviceProviderURL(client, spID), nil)
+	return result
+}
+
+// ListAll returns every registered service provider.
+func ListAll(client *gophercloud.ServiceClient) ([]ServiceProvider, error) {
+	pages, err := List(client).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	return ExtractServiceProviders(pages)
+}
@@ -0,0 +1,71 @@
+package serviceproviders
+
+import (
+	"testing"
+
+	"github.com/rackspace/gophercloud"
+	th "github.com/rackspace/gophercloud/testhelper"
+	"github.com/rackspace/gophercloud/testhelper/client"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListServiceProvidersSuccessfully(t)
+
+	actual, err := ListAll(client.ServiceClient())
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []ServiceProvider{PartnerCloud}, actual)
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleServiceProviderSuccessfully(t)
+
+	actual, err := Get(client.ServiceClient(), "partner-cloud").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, PartnerCloud, *actual)
+}
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleServiceProviderSuccessfully(t)
+
+	opts := CreateOpts{
+		AuthURL:          PartnerCloud.AuthURL,
+		SPURL:            PartnerCloud.SPURL,
+		Description:      "Partner cloud",
+		Enabled:          gophercloud.Enabled,
+		RelayStatePrefix: "ss:mem:",
+	}
+	actual, err := Create(client.ServiceClient(), "partner-cloud", opts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, PartnerCloud, *actual)
+}
+
+func TestCreateRequiresURLs(t *testing.T) {
+	res := Create(client.ServiceClient(), "partner-cloud", CreateOpts{AuthURL: PartnerCloud.AuthURL})
+	if res.Err == nil {
+		t.Fatalf("Expected an error for a service provider without an SPURL")
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleServiceProviderSuccessfully(t)
+
+	actual, err := Update(client.ServiceClient(), "partner-cloud", UpdateOpts{Description: "Partner cloud"}).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, PartnerCloud, *actual)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleServiceProviderSuccessfully(t)
+
+	th.AssertNoErr(t, Delete(client.ServiceClient(), "partner-cloud").ExtractErr())
+}
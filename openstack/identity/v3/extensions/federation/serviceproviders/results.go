@@ -0,0 +1,98 @@
+package serviceproviders
+
+import (
+	"github.com/rackspace/gophercloud"
+	"github.com/rackspace/gophercloud/pagination"
+
+	"github.com/mitchellh/mapstructure"
+)
+
+// ServiceProvider is a remote cloud that tokens can be federated to.
+type ServiceProvider struct {
+	// ID is the unique ID of the service provider.
+	ID string `mapstructure:"id"`
+
+	// AuthURL is the URL at which the service provider accepts federated assertions.
+	AuthURL string `mapstructure:"auth_url"`
+
+	// SPURL is the URL to which SAML assertions are posted.
+	SPURL string `mapstructure:"sp_url"`
+
+	// Description describes the service provider.
+	Description string `mapstructure:"description"`
+
+	// Enabled is false if the service provider has been disabled.
+	Enabled bool `mapstructure:"enabled"`
+
+	// RelayStatePrefix is the prefix of the RelayState of the SAML ECP wrapper.
+	RelayStatePrefix string `mapstructure:"relay_state_prefix"`
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a GetResult, CreateResult or UpdateResult as a concrete ServiceProvider.
+// An error is returned if the original call or the extraction failed.
+func (r commonResult) Extract() (*ServiceProvider, error) {
+	if r.Err != nil {
+		return nil, r.Err
+	}
+
+	var res struct {
+		ServiceProvider ServiceProvider `mapstructure:"service_provider"`
+	}
+
+	err := mapstructure.Decode(r.Body, &res)
+
+	return &res.ServiceProvider, err
+}
+
+// CreateResult is the deferred result of a Create call.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult is the deferred result of a Get call.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult is the deferred result of an Update call.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult is the deferred result of a Delete call.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// ServiceProviderPage is a single page of ServiceProvider results.
+type ServiceProviderPage struct {
+	pagination.LinkedPageBase
+}
+
+// CollectionKey returns "service_providers".
+func (page ServiceProviderPage) CollectionKey() string {
+	return "service_providers"
+}
+
+// IsEmpty returns true if the page contains no results.
+func (page ServiceProviderPage) IsEmpty() (bool, error) {
+	sps, err := ExtractServiceProviders(page)
+	if err != nil {
+		return true, err
+	}
+	return len(sps) == 0, nil
+}
+
+// ExtractServiceProviders extracts a slice of ServiceProviders from a page acquired from List.
+func ExtractServiceProviders(page pagination.Page) ([]ServiceProvider, error) {
+	var response struct {
+		ServiceProviders []ServiceProvider `mapstructure:"service_providers"`
+	}
+
+	err := mapstructure.Decode(page.(ServiceProviderPage).Body, &response)
+	return response.ServiceProviders, err
+}
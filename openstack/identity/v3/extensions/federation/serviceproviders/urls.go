@@ -0,0 +1,11 @@
+package serviceproviders
+
+import "github.com/rackspace/gophercloud"
+
+func listURL(client *gophercloud.ServiceClient) string {
+	return client.ServiceURL("OS-FEDERATION", "service_providers")
+}
+
+func serviceProviderURL(client *gophercloud.ServiceClient, spID string) string {
+	return client.ServiceURL("OS-FEDERATION", "service_providers", spID)
+}
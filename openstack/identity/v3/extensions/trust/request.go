@@ -67,17 +67,23 @@ func trustv3auth(client *gophercloud.ProviderClient, endpoint string, options Au
 		return err
 	}
 
-	client.TokenID = token.ID
+	client.SetToken(token.ID)
+	client.SetTokenExpiresAt(token.ExpiresAt)
 
 	if options.AuthOptions.AllowReauth {
 		client.ReauthFunc = func() error {
-			client.TokenID = ""
-			return trustv3auth(client, endpoint, options)
+			tac := client.ReauthClient()
+			if err := trustv3auth(tac, endpoint, options); err != nil {
+				return err
+			}
+			client.CopyTokenFrom(tac)
+			return nil
 		}
 	}
 	client.EndpointLocator = func(opts gophercloud.EndpointOpts) (string, error) {
 		return openstack.V3EndpointURL(catalog, opts)
 	}
+	client.ServiceCatalog = openstack.V3ServiceCatalog(catalog)
 
 	return nil
 }
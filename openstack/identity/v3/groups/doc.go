@@ -0,0 +1,4 @@
+// Package groups provides information and interaction with the groups API
+// resource for the OpenStack Identity service. Group membership is managed
+// through the users package.
+package groups
@@ -0,0 +1,129 @@
+// +build fixtures
+
+package groups
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/rackspace/gophercloud/testhelper"
+	fake "github.com/rackspace/gophercloud/testhelper/client"
+)
+
+// GroupOutput is a group as the service returns it.
+const GroupOutput = `
+{
+	"group": {
+		"id": "9fe1d3",
+		"name": "support",
+		"description": "Support personnel",
+		"domain_id": "default"
+	}
+}
+`
+
+// ListOutput is a page of groups as the service returns it.
+const ListOutput = `
+{
+	"links": {
+		"next": null,
+		"previous": null
+	},
+	"groups": [
+		{
+			"id": "9fe1d3",
+			"name": "support",
+			"description": "Support personnel",
+			"domain_id": "default"
+		},
+		{
+			"id": "c0d675",
+			"name": "admins",
+			"description": "",
+			"domain_id": "1789d1"
+		}
+	]
+}
+`
+
+// SupportGroup is the expected result of extracting GroupOutput.
+var SupportGroup = Group{
+	ID:          "9fe1d3",
+	Name:        "support",
+	Description: "Support personnel",
+	DomainID:    "default",
+}
+
+// AdminsGroup is the second group in ListOutput.
+var AdminsGroup = Group{
+	ID:       "c0d675",
+	Name:     "admins",
+	DomainID: "1789d1",
+}
+
+// HandleListGroupsSuccessfully sets up the test server to respond to a List request.
+func HandleListGroupsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/groups", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"domain_id": "default"})
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, ListOutput)
+	})
+}
+
+// HandleGetGroupSuccessfully sets up the test server to respond to a Get request.
+func HandleGetGroupSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/groups/9fe1d3", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, GroupOutput)
+	})
+}
+
+// HandleCreateGroupSuccessfully sets up the test server to respond to a Create request.
+func HandleCreateGroupSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/groups", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, `
+			{
+				"group": {
+					"name": "support",
+					"description": "Support personnel",
+					"domain_id": "default"
+				}
+			}
+		`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, GroupOutput)
+	})
+}
+
+// HandleUpdateGroupSuccessfully sets up the test server to respond to an Update request.
+func HandleUpdateGroupSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/groups/9fe1d3", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PATCH")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, `{ "group": { "description": "Support personnel" } }`)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, GroupOutput)
+	})
+}
+
+// HandleDeleteGroupSuccessfully sets up the test server to respond to a Delete request.
+func HandleDeleteGroupSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/groups/9fe1d3", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
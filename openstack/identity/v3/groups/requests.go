@@ -0,0 +1,166 @@
+package groups
+
+import (
+	"errors"
+
+	"github.com/rackspace/gophercloud"
+	"github.com/rackspace/gophercloud/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the List request.
+type ListOptsBuilder interface {
+	ToGroupListQuery() (string, error)
+}
+
+// ListOpts allows you to filter the groups returned by the List method.
+type ListOpts struct {
+	// DomainID limits the results to the groups owned by a domain.
+	DomainID string `q:"domain_id"`
+
+	// Name limits the results to the group with the given name.
+	Name string `q:"name"`
+}
+
+// ToGroupListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToGroupListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), nil
+}
+
+// List enumerates the groups that the current user is allowed to see.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(client)
+	if opts != nil {
+		query, err := opts.ToGroupListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+
+	createPage := func(r pagination.PageResult) pagination.Page {
+		return GroupPage{pagination.LinkedPageBase{PageResult: r}}
+	}
+
+	return pagination.NewPager(client, url, createPage)
+}
+
+// Get returns additional information about a group, given its ID.
+func Get(client *gophercloud.ServiceClient, groupID string) GetResult {
+	var result GetResult
+	_, result.Err = client.Get(groupURL(client, groupID), &result.Body, nil)
+	return result
+}
+
+// CreateOptsBuilder describes struct types that can be accepted by the Create call.
+type CreateOptsBuilder interface {
+	ToGroupCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts contains the attributes of a new group.
+type CreateOpts struct {
+	// Name is the name of the group. It's required, and must be unique within its domain.
+	Name string
+
+	// DomainID is the ID of the domain that owns the group. It defaults to the domain of the
+	// current token.
+	DomainID string
+
+	// Description describes the group.
+	Description string
+}
+
+// ToGroupCreateMap assembles a request body based on the contents of a CreateOpts.
+func (opts CreateOpts) ToGroupCreateMap() (map[string]interface{}, error) {
+	if opts.Name == "" {
+		return nil, errors.New("A Name must be provided")
+	}
+
+	g := map[string]interface{}{"name": opts.Name}
+	if opts.DomainID != "" {
+		g["domain_id"] = opts.DomainID
+	}
+	if opts.Description != "" {
+		g["description"] = opts.Description
+	}
+
+	return map[string]interface{}{"group": g}, nil
+}
+
+// Create adds a new group.
+func Create(client *gophercloud.ServiceClient, opts CreateOptsBuilder) CreateResult {
+	var result CreateResult
+
+	reqBody, err := opts.ToGroupCreateMap()
+	if err != nil {
+		result.Err = err
+		return result
+	}
+
+	_, result.Err = client.Post(listURL(client), reqBody, &result.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return result
+}
+
+// UpdateOptsBuilder allows extensions to add additional attributes to the Update request.
+type UpdateOptsBuilder interface {
+	ToGroupUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts contains the attributes of a group that may be changed. Attributes left empty are
+// not changed.
+type UpdateOpts struct {
+	Name        string
+	Description string
+}
+
+// ToGroupUpdateMap assembles a request body based on the contents of an UpdateOpts.
+func (opts UpdateOpts) ToGroupUpdateMap() (map[string]interface{}, error) {
+	g := make(map[string]interface{})
+	if opts.Name != "" {
+		g["name"] = opts.Name
+	}
+	if opts.Description != "" {
+		g["description"] = opts.Description
+	}
+
+	return map[string]interface{}{"group": g}, nil
+}
+
+// Update changes the attributes of an existing group.
+func Update(client *gophercloud.ServiceClient, groupID string, opts UpdateOptsBuilder) UpdateResult {
+	var result UpdateResult
+
+	reqBody, err := opts.ToGroupUpdateMap()
+	if err != nil {
+		result.Err = err
+		return result
+	}
+
+	_, result.Err = client.Request("PATCH", groupURL(client, groupID), gophercloud.RequestOpts{
+		JSONBody:     reqBody,
+		JSONResponse: &result.Body,
+		OkCodes:      []int{200},
+	})
+	return result
+}
+
+// Delete removes a group. Its members aren't deleted, but lose any roles granted through it.
+func Delete(client *gophercloud.ServiceClient, groupID string) DeleteResult {
+	var result DeleteResult
+	_, result.Err = client.Delete(groupURL(client, groupID), nil)
+	return result
+}
+
+// ListAll returns the groups that match opts.
+func ListAll(client *gophercloud.ServiceClient, opts ListOptsBuilder) ([]Group, error) {
+	pages, err := List(client, opts).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	return ExtractGroups(pages)
+}
@@ -0,0 +1,83 @@
+package groups
+
+import (
+	"testing"
+
+	"github.com/rackspace/gophercloud/pagination"
+	th "github.com/rackspace/gophercloud/testhelper"
+	"github.com/rackspace/gophercloud/testhelper/client"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListGroupsSuccessfully(t)
+
+	count := 0
+	err := List(client.ServiceClient(), ListOpts{DomainID: "default"}).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		actual, err := ExtractGroups(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, []Group{SupportGroup, AdminsGroup}, actual)
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 1, count)
+}
+
+func TestListAll(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListGroupsSuccessfully(t)
+
+	actual, err := ListAll(client.ServiceClient(), ListOpts{DomainID: "default"})
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []Group{SupportGroup, AdminsGroup}, actual)
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetGroupSuccessfully(t)
+
+	actual, err := Get(client.ServiceClient(), "9fe1d3").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, SupportGroup, *actual)
+}
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateGroupSuccessfully(t)
+
+	opts := CreateOpts{Name: "support", DomainID: "default", Description: "Support personnel"}
+	actual, err := Create(client.ServiceClient(), opts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, SupportGroup, *actual)
+}
+
+func TestCreateRequiresName(t *testing.T) {
+	res := Create(client.ServiceClient(), CreateOpts{DomainID: "default"})
+	if res.Err == nil {
+		t.Fatalf("Expected an error for a group without a name")
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdateGroupSuccessfully(t)
+
+	actual, err := Update(client.ServiceClient(), "9fe1d3", UpdateOpts{Description: "Support personnel"}).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, SupportGroup, *actual)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteGroupSuccessfully(t)
+
+	err := Delete(client.ServiceClient(), "9fe1d3").ExtractErr()
+	th.AssertNoErr(t, err)
+}
@@ -0,0 +1,92 @@
+package groups
+
+import (
+	"github.com/rackspace/gophercloud"
+	"github.com/rackspace/gophercloud/pagination"
+
+	"github.com/mitchellh/mapstructure"
+)
+
+// Group is a collection of users that can be granted roles together.
+type Group struct {
+	// ID is the unique ID of the group.
+	ID string `mapstructure:"id"`
+
+	// Name is the name of the group, which is unique within its domain.
+	Name string `mapstructure:"name"`
+
+	// Description describes the group.
+	Description string `mapstructure:"description"`
+
+	// DomainID is the ID of the domain that owns the group.
+	DomainID string `mapstructure:"domain_id"`
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a GetResult, CreateResult or UpdateResult as a concrete Group.
+// An error is returned if the original call or the extraction failed.
+func (r commonResult) Extract() (*Group, error) {
+	if r.Err != nil {
+		return nil, r.Err
+	}
+
+	var res struct {
+		Group Group `mapstructure:"group"`
+	}
+
+	err := mapstructure.Decode(r.Body, &res)
+
+	return &res.Group, err
+}
+
+// CreateResult is the deferred result of a Create call.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult is the deferred result of a Get call.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult is the deferred result of an Update call.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult is the deferred result of a Delete call.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// GroupPage is a single page of Group results.
+type GroupPage struct {
+	pagination.LinkedPageBase
+}
+
+// CollectionKey returns "groups".
+func (page GroupPage) CollectionKey() string {
+	return "groups"
+}
+
+// IsEmpty returns true if the page contains no results.
+func (page GroupPage) IsEmpty() (bool, error) {
+	groups, err := ExtractGroups(page)
+	if err != nil {
+		return true, err
+	}
+	return len(groups) == 0, nil
+}
+
+// ExtractGroups extracts a slice of Groups from a page acquired from List.
+func ExtractGroups(page pagination.Page) ([]Group, error) {
+	var response struct {
+		Groups []Group `mapstructure:"groups"`
+	}
+
+	err := mapstructure.Decode(page.(GroupPage).Body, &response)
+	return response.Groups, err
+}
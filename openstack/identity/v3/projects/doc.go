@@ -0,0 +1,13 @@
+// Package projects provides information and interaction with the projects API
+// resource for the OpenStack Identity service.
+//
+// Projects may be nested: a project created with a ParentID becomes a child of
+// that project, and GetHierarchy retrieves a project along with its parents and
+// its subtree. Projects may also be tagged, and List can filter them by tag:
+//
+//   opts := projects.ListOpts{
+//     ParentID: "a3f1b8",
+//     Tags:     "production,eu",
+//   }
+//   all, err := projects.ListAll(client, opts)
+package projects
@@ -0,0 +1,227 @@
+// +build fixtures
+
+package projects
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/rackspace/gophercloud/testhelper"
+	fake "github.com/rackspace/gophercloud/testhelper/client"
+)
+
+// ProjectOutput is a project as the service returns it.
+const ProjectOutput = `
+{
+	"project": {
+		"id": "263fd9",
+		"name": "web",
+		"description": "Web frontends",
+		"domain_id": "1789d1",
+		"parent_id": "a3f1b8",
+		"is_domain": false,
+		"enabled": true,
+		"tags": ["production", "eu"],
+		"links": {
+			"self": "http://localhost:5000/v3/projects/263fd9"
+		}
+	}
+}
+`
+
+// HierarchyOutput is a project with its parents and subtree as the service returns it.
+const HierarchyOutput = `
+{
+	"project": {
+		"id": "a3f1b8",
+		"name": "platform",
+		"description": "",
+		"domain_id": "1789d1",
+		"parent_id": "1789d1",
+		"is_domain": false,
+		"enabled": true,
+		"tags": [],
+		"parents": [
+			{
+				"project": {
+					"id": "1789d1",
+					"name": "acme",
+					"domain_id": null,
+					"parent_id": null,
+					"is_domain": true,
+					"enabled": true,
+					"tags": []
+				}
+			}
+		],
+		"subtree": [
+			{
+				"project": {
+					"id": "263fd9",
+					"name": "web",
+					"description": "Web frontends",
+					"domain_id": "1789d1",
+					"parent_id": "a3f1b8",
+					"is_domain": false,
+					"enabled": true,
+					"tags": ["production", "eu"]
+				}
+			}
+		]
+	}
+}
+`
+
+// ListOutput is a page of projects as the service returns it.
+const ListOutput = `
+{
+	"links": {
+		"next": null,
+		"previous": null
+	},
+	"projects": [
+		{
+			"id": "263fd9",
+			"name": "web",
+			"description": "Web frontends",
+			"domain_id": "1789d1",
+			"parent_id": "a3f1b8",
+			"is_domain": false,
+			"enabled": true,
+			"tags": ["production", "eu"]
+		}
+	]
+}
+`
+
+// WebProject is the expected result of extracting ProjectOutput.
+var WebProject = Project{
+	ID:          "263fd9",
+	Name:        "web",
+	Description: "Web frontends",
+	DomainID:    "1789d1",
+	ParentID:    "a3f1b8",
+	Enabled:     true,
+	Tags:        []string{"production", "eu"},
+}
+
+// PlatformProject is the expected result of extracting HierarchyOutput.
+var PlatformProject = Project{
+	ID:       "a3f1b8",
+	Name:     "platform",
+	DomainID: "1789d1",
+	ParentID: "1789d1",
+	Enabled:  true,
+	Tags:     []string{},
+	Parents: []Project{
+		{
+			ID:       "1789d1",
+			Name:     "acme",
+			IsDomain: true,
+			Enabled:  true,
+			Tags:     []string{},
+		},
+	},
+	Subtree: []Project{WebProject},
+}
+
+// HandleListProjectsSuccessfully sets up the test server to respond to a List request.
+func HandleListProjectsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/projects", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"parent_id": "a3f1b8",
+			"tags":      "production,eu",
+		})
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, ListOutput)
+	})
+}
+
+// HandleGetProjectSuccessfully sets up the test server to respond to a Get request.
+func HandleGetProjectSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/projects/263fd9", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, ProjectOutput)
+	})
+}
+
+// HandleGetHierarchySuccessfully sets up the test server to respond to a GetHierarchy request
+// for both parents and subtree.
+func HandleGetHierarchySuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/projects/a3f1b8", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"parents_as_list": "true",
+			"subtree_as_list": "true",
+		})
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, HierarchyOutput)
+	})
+}
+
+// HandleCreateProjectSuccessfully sets up the test server to respond to a Create request.
+func HandleCreateProjectSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/projects", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, `
+			{
+				"project": {
+					"name": "web",
+					"description": "Web frontends",
+					"parent_id": "a3f1b8",
+					"tags": ["production", "eu"]
+				}
+			}
+		`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, ProjectOutput)
+	})
+}
+
+// HandleUpdateProjectSuccessfully sets up the test server to respond to an Update request that
+// removes the project's tags.
+func HandleUpdateProjectSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/projects/263fd9", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PATCH")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, `{ "project": { "name": "web", "tags": [] } }`)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `
+			{
+				"project": {
+					"id": "263fd9",
+					"name": "web",
+					"description": "Web frontends",
+					"domain_id": "1789d1",
+					"parent_id": "a3f1b8",
+					"is_domain": false,
+					"enabled": true,
+					"tags": []
+				}
+			}
+		`)
+	})
+}
+
+// HandleDeleteProjectSuccessfully sets up the test server to respond to a Delete request.
+func HandleDeleteProjectSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/projects/263fd9", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -0,0 +1,241 @@
+package projects
+
+import (
+	"errors"
+
+	"github.com/rackspace/gophercloud"
+	"github.com/rackspace/gophercloud/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the List request.
+type ListOptsBuilder interface {
+	ToProjectListQuery() (string, error)
+}
+
+// ListOpts allows you to filter the projects returned by the List method.
+type ListOpts struct {
+	// DomainID limits the results to the projects owned by a domain.
+	DomainID string `q:"domain_id"`
+
+	// ParentID limits the results to the direct children of a project.
+	ParentID string `q:"parent_id"`
+
+	// Name limits the results to the project with the given name.
+	Name string `q:"name"`
+
+	// Enabled limits the results to enabled or disabled projects.
+	Enabled *bool `q:"enabled"`
+
+	// IsDomain limits the results to projects that are, or aren't, acting as domains.
+	IsDomain *bool `q:"is_domain"`
+
+	// Tags, TagsAny, NotTags and NotTagsAny filter the results by comma-separated lists of tags:
+	// Tags matches projects with all of the tags, TagsAny those with any of them, and NotTags and
+	// NotTagsAny exclude projects in the same way.
+	Tags       string `q:"tags"`
+	TagsAny    string `q:"tags-any"`
+	NotTags    string `q:"not-tags"`
+	NotTagsAny string `q:"not-tags-any"`
+}
+
+// ToProjectListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToProjectListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), nil
+}
+
+// List enumerates the projects that the current user is allowed to see.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(client)
+	if opts != nil {
+		query, err := opts.ToProjectListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+
+	createPage := func(r pagination.PageResult) pagination.Page {
+		return ProjectPage{pagination.LinkedPageBase{PageResult: r}}
+	}
+
+	return pagination.NewPager(client, url, createPage)
+}
+
+// Get returns additional information about a project, given its ID.
+func Get(client *gophercloud.ServiceClient, projectID string) GetResult {
+	var result GetResult
+	_, result.Err = client.Get(projectURL(client, projectID), &result.Body, nil)
+	return result
+}
+
+// HierarchyOpts selects the parts of a project's hierarchy that GetHierarchy retrieves.
+type HierarchyOpts struct {
+	// Parents includes the project's ancestors, nearest first, in its Parents.
+	Parents bool `q:"parents_as_list"`
+
+	// Subtree includes the project's descendants in its Subtree.
+	Subtree bool `q:"subtree_as_list"`
+}
+
+// GetHierarchy returns a project along with its ancestors and/or descendants. Only the projects
+// that the current user is allowed to see are included.
+func GetHierarchy(client *gophercloud.ServiceClient, projectID string, opts HierarchyOpts) GetResult {
+	var result GetResult
+
+	q, err := gophercloud.BuildQueryString(opts)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+
+	_, result.Err = client.Get(projectURL(client, projectID)+q.String(), &result.Body, nil)
+	return result
+}
+
+// CreateOptsBuilder describes struct types that can be accepted by the Create call.
+type CreateOptsBuilder interface {
+	ToProjectCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts contains the attributes of a new project.
+type CreateOpts struct {
+	// Name is the name of the project. It's required, and must be unique among its siblings.
+	Name string
+
+	// DomainID is the ID of the domain that owns the project. It defaults to the domain of the
+	// parent project, or else to the domain of the current token.
+	DomainID string
+
+	// ParentID makes the new project a child of an existing project.
+	ParentID string
+
+	// Description describes the project.
+	Description string
+
+	// Enabled determines whether the project is enabled. Projects are enabled by default.
+	Enabled gophercloud.EnabledState
+
+	// IsDomain creates a project that acts as a domain.
+	IsDomain bool
+
+	// Tags labels the project, e.g. for filtering with List.
+	Tags []string
+}
+
+// ToProjectCreateMap assembles a request body based on the contents of a CreateOpts.
+func (opts CreateOpts) ToProjectCreateMap() (map[string]interface{}, error) {
+	if opts.Name == "" {
+		return nil, errors.New("A Name must be provided")
+	}
+
+	p := map[string]interface{}{"name": opts.Name}
+	if opts.DomainID != "" {
+		p["domain_id"] = opts.DomainID
+	}
+	if opts.ParentID != "" {
+		p["parent_id"] = opts.ParentID
+	}
+	if opts.Description != "" {
+		p["description"] = opts.Description
+	}
+	if opts.Enabled != nil {
+		p["enabled"] = *opts.Enabled
+	}
+	if opts.IsDomain {
+		p["is_domain"] = true
+	}
+	if len(opts.Tags) > 0 {
+		p["tags"] = opts.Tags
+	}
+
+	return map[string]interface{}{"project": p}, nil
+}
+
+// Create adds a new project.
+func Create(client *gophercloud.ServiceClient, opts CreateOptsBuilder) CreateResult {
+	var result CreateResult
+
+	reqBody, err := opts.ToProjectCreateMap()
+	if err != nil {
+		result.Err = err
+		return result
+	}
+
+	_, result.Err = client.Post(listURL(client), reqBody, &result.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return result
+}
+
+// UpdateOptsBuilder allows extensions to add additional attributes to the Update request.
+type UpdateOptsBuilder interface {
+	ToProjectUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts contains the attributes of a project that may be changed. Attributes left empty are
+// not changed. A project can't be moved to a different parent.
+type UpdateOpts struct {
+	Name        string
+	Description string
+	Enabled     gophercloud.EnabledState
+
+	// Tags replaces the project's tags. A non-nil, empty slice removes all of them.
+	Tags []string
+}
+
+// ToProjectUpdateMap assembles a request body based on the contents of an UpdateOpts.
+func (opts UpdateOpts) ToProjectUpdateMap() (map[string]interface{}, error) {
+	p := make(map[string]interface{})
+	if opts.Name != "" {
+		p["name"] = opts.Name
+	}
+	if opts.Description != "" {
+		p["description"] = opts.Description
+	}
+	if opts.Enabled != nil {
+		p["enabled"] = *opts.Enabled
+	}
+	if opts.Tags != nil {
+		p["tags"] = opts.Tags
+	}
+
+	return map[string]interface{}{"project": p}, nil
+}
+
+// Update changes the attributes of an existing project.
+func Update(client *gophercloud.ServiceClient, projectID string, opts UpdateOptsBuilder) UpdateResult {
+	var result UpdateResult
+
+	reqBody, err := opts.ToProjectUpdateMap()
+	if err != nil {
+		result.Err = err
+		return result
+	}
+
+	_, result.Err = client.Request("PATCH", projectURL(client, projectID), gophercloud.RequestOpts{
+		JSONBody:     reqBody,
+		JSONResponse: &result.Body,
+		OkCodes:      []int{200},
+	})
+	return result
+}
+
+// Delete removes a project. Projects that have children can't be deleted.
+func Delete(client *gophercloud.ServiceClient, projectID string) DeleteResult {
+	var result DeleteResult
+	_, result.Err = client.Delete(projectURL(client, projectID), nil)
+	return result
+}
+
+// ListAll returns the projects that match opts.
+func ListAll(client *gophercloud.ServiceClient, opts ListOptsBuilder) ([]Project, error) {
+	pages, err := List(client, opts).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	return ExtractProjects(pages)
+}
@@ -0,0 +1,95 @@
+package projects
+
+import (
+	"testing"
+
+	"github.com/rackspace/gophercloud/pagination"
+	th "github.com/rackspace/gophercloud/testhelper"
+	"github.com/rackspace/gophercloud/testhelper/client"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListProjectsSuccessfully(t)
+
+	opts := ListOpts{ParentID: "a3f1b8", Tags: "production,eu"}
+	count := 0
+	err := List(client.ServiceClient(), opts).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		actual, err := ExtractProjects(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, []Project{WebProject}, actual)
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 1, count)
+}
+
+func TestListAll(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListProjectsSuccessfully(t)
+
+	actual, err := ListAll(client.ServiceClient(), ListOpts{ParentID: "a3f1b8", Tags: "production,eu"})
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []Project{WebProject}, actual)
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetProjectSuccessfully(t)
+
+	actual, err := Get(client.ServiceClient(), "263fd9").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, WebProject, *actual)
+}
+
+func TestGetHierarchy(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetHierarchySuccessfully(t)
+
+	actual, err := GetHierarchy(client.ServiceClient(), "a3f1b8", HierarchyOpts{Parents: true, Subtree: true}).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, PlatformProject, *actual)
+}
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateProjectSuccessfully(t)
+
+	opts := CreateOpts{
+		Name:        "web",
+		Description: "Web frontends",
+		ParentID:    "a3f1b8",
+		Tags:        []string{"production", "eu"},
+	}
+	actual, err := Create(client.ServiceClient(), opts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, WebProject, *actual)
+}
+
+func TestUpdateClearsTags(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdateProjectSuccessfully(t)
+
+	actual, err := Update(client.ServiceClient(), "263fd9", UpdateOpts{Name: "web", Tags: []string{}}).Extract()
+	th.AssertNoErr(t, err)
+
+	expected := WebProject
+	expected.Tags = []string{}
+	th.CheckDeepEquals(t, expected, *actual)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteProjectSuccessfully(t)
+
+	err := Delete(client.ServiceClient(), "263fd9").ExtractErr()
+	th.AssertNoErr(t, err)
+}
@@ -0,0 +1,128 @@
+package projects
+
+import (
+	"github.com/rackspace/gophercloud"
+	"github.com/rackspace/gophercloud/pagination"
+
+	"github.com/mitchellh/mapstructure"
+)
+
+// Project is a container for resources, to which users are granted roles.
+type Project struct {
+	// ID is the unique ID of the project.
+	ID string `mapstructure:"id"`
+
+	// Name is the name of the project, which is unique among its siblings.
+	Name string `mapstructure:"name"`
+
+	// Description describes the project.
+	Description string `mapstructure:"description"`
+
+	// DomainID is the ID of the domain that owns the project.
+	DomainID string `mapstructure:"domain_id"`
+
+	// ParentID is the ID of the project's parent. Top-level projects have their domain as their
+	// parent.
+	ParentID string `mapstructure:"parent_id"`
+
+	// IsDomain is true if the project acts as a domain.
+	IsDomain bool `mapstructure:"is_domain"`
+
+	// Enabled is false if the project has been disabled, in which case tokens can't be scoped to it.
+	Enabled bool `mapstructure:"enabled"`
+
+	// Tags are the labels that the project has been tagged with.
+	Tags []string `mapstructure:"tags"`
+
+	// Parents and Subtree are the project's ancestors and descendants, if they were requested with
+	// GetHierarchy.
+	Parents []Project `mapstructure:"-"`
+	Subtree []Project `mapstructure:"-"`
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a GetResult, CreateResult or UpdateResult as a concrete Project.
+// An error is returned if the original call or the extraction failed.
+func (r commonResult) Extract() (*Project, error) {
+	if r.Err != nil {
+		return nil, r.Err
+	}
+
+	// The service wraps each project in a hierarchy in its own object.
+	type hierarchyEntry struct {
+		Project Project `mapstructure:"project"`
+	}
+
+	var res struct {
+		Project struct {
+			Project `mapstructure:",squash"`
+			Parents []hierarchyEntry `mapstructure:"parents"`
+			Subtree []hierarchyEntry `mapstructure:"subtree"`
+		} `mapstructure:"project"`
+	}
+
+	if err := mapstructure.Decode(r.Body, &res); err != nil {
+		return nil, err
+	}
+
+	project := res.Project.Project
+	for _, entry := range res.Project.Parents {
+		project.Parents = append(project.Parents, entry.Project)
+	}
+	for _, entry := range res.Project.Subtree {
+		project.Subtree = append(project.Subtree, entry.Project)
+	}
+	return &project, nil
+}
+
+// CreateResult is the deferred result of a Create call.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult is the deferred result of a Get or GetHierarchy call.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult is the deferred result of an Update call.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult is the deferred result of a Delete call.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// ProjectPage is a single page of Project results.
+type ProjectPage struct {
+	pagination.LinkedPageBase
+}
+
+// CollectionKey returns "projects"; the page's "links" aren't part of the collection.
+func (page ProjectPage) CollectionKey() string {
+	return "projects"
+}
+
+// IsEmpty returns true if the page contains no results.
+func (page ProjectPage) IsEmpty() (bool, error) {
+	projects, err := ExtractProjects(page)
+	if err != nil {
+		return true, err
+	}
+	return len(projects) == 0, nil
+}
+
+// ExtractProjects extracts a slice of Projects from a page acquired from List.
+func ExtractProjects(page pagination.Page) ([]Project, error) {
+	var response struct {
+		Projects []Project `mapstructure:"projects"`
+	}
+
+	err := mapstructure.Decode(page.(ProjectPage).Body, &response)
+	return response.Projects, err
+}
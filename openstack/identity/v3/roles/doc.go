@@ -1,3 +1,6 @@
 // Package roles provides information and interaction with the roles API
-// resource for the OpenStack Identity service.
+// resource for the OpenStack Identity service. Besides managing the roles
+// themselves, it grants and revokes role assignments, including the inherited
+// assignments of the OS-INHERIT extension, and manages the inference rules by
+// which one role implies another.
 package roles
@@ -0,0 +1,15 @@
+package roles
+
+import "errors"
+
+var (
+	// ErrNameRequired is reported if a Role is created without a Name.
+	ErrNameRequired = errors.New("You must specify a name for this role.")
+
+	// ErrActorRequired is reported if a role assignment names neither or both of a user and a group.
+	ErrActorRequired = errors.New("You must specify exactly one of a UserID or a GroupID for this role assignment.")
+
+	// ErrTargetRequired is reported if a role assignment names neither or both of a project and a
+	// domain.
+	ErrTargetRequired = errors.New("You must specify exactly one of a ProjectID or a DomainID for this role assignment.")
+)
@@ -0,0 +1,237 @@
+// +build fixtures
+
+package roles
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/rackspace/gophercloud/testhelper"
+	fake "github.com/rackspace/gophercloud/testhelper/client"
+)
+
+// RoleOutput is a domain-specific role as the service returns it.
+const RoleOutput = `
+{
+	"role": {
+		"id": "a720d4",
+		"name": "operator",
+		"domain_id": "1789d1",
+		"description": "Operates the domain's services",
+		"links": {
+			"self": "http://localhost:5000/v3/roles/a720d4"
+		}
+	}
+}
+`
+
+// ListOutput is a page of roles as the service returns it.
+const ListOutput = `
+{
+	"links": {
+		"next": null,
+		"previous": null
+	},
+	"roles": [
+		{
+			"id": "9fe2ff",
+			"name": "member",
+			"domain_id": null,
+			"description": null
+		},
+		{
+			"id": "a720d4",
+			"name": "operator",
+			"domain_id": "1789d1",
+			"description": "Operates the domain's services"
+		}
+	]
+}
+`
+
+// MemberRole is the first role in ListOutput.
+var MemberRole = Role{
+	ID:   "9fe2ff",
+	Name: "member",
+}
+
+// OperatorRole is the expected result of extracting RoleOutput.
+var OperatorRole = Role{
+	ID:          "a720d4",
+	Name:        "operator",
+	DomainID:    "1789d1",
+	Description: "Operates the domain's services",
+}
+
+// HandleListRolesSuccessfully sets up the test server to respond to a List request.
+func HandleListRolesSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/roles", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, ListOutput)
+	})
+}
+
+// HandleGetRoleSuccessfully sets up the test server to respond to a Get request.
+func HandleGetRoleSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/roles/a720d4", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, RoleOutput)
+	})
+}
+
+// HandleCreateRoleSuccessfully sets up the test server to respond to a Create request.
+func HandleCreateRoleSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/roles", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, `
+			{
+				"role": {
+					"name": "operator",
+					"domain_id": "1789d1",
+					"description": "Operates the domain's services"
+				}
+			}
+		`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, RoleOutput)
+	})
+}
+
+// HandleUpdateRoleSuccessfully sets up the test server to respond to an Update request.
+func HandleUpdateRoleSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/roles/a720d4", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PATCH")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, `{ "role": { "description": "Operates the domain's services" } }`)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, RoleOutput)
+	})
+}
+
+// HandleDeleteRoleSuccessfully sets up the test server to respond to a Delete request.
+func HandleDeleteRoleSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/roles/a720d4", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// HandleAssignmentSuccessfully sets up the test server to respond to Assign and Unassign requests
+// on the given assignment path.
+func HandleAssignmentSuccessfully(t *testing.T, path string) {
+	th.Mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		if r.Method != "PUT" && r.Method != "DELETE" {
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// ImpliedRoleOutput is the inference rule that operator implies member, as the service returns it.
+const ImpliedRoleOutput = `
+{
+	"role_inference": {
+		"prior_role": {
+			"id": "a720d4",
+			"name": "operator"
+		},
+		"implies": {
+			"id": "9fe2ff",
+			"name": "member"
+		}
+	}
+}
+`
+
+// HandleImpliedRoleSuccessfully sets up the test server to respond to CreateImpliedRole,
+// GetImpliedRole and DeleteImpliedRole requests for the rule that operator implies member.
+func HandleImpliedRoleSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/roles/a720d4/implies/9fe2ff", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		switch r.Method {
+		case "PUT":
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprintf(w, ImpliedRoleOutput)
+		case "GET":
+			w.Header().Add("Content-Type", "application/json")
+			fmt.Fprintf(w, ImpliedRoleOutput)
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	})
+}
+
+// HandleListImpliedRolesSuccessfully sets up the test server to respond to a ListImpliedRoles
+// request.
+func HandleListImpliedRolesSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/roles/a720d4/implies", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `
+			{
+				"role_inference": {
+					"prior_role": {
+						"id": "a720d4",
+						"name": "operator"
+					},
+					"implies": [
+						{
+							"id": "9fe2ff",
+							"name": "member"
+						}
+					]
+				}
+			}
+		`)
+	})
+}
+
+// HandleListInferenceRulesSuccessfully sets up the test server to respond to a ListInferenceRules
+// request.
+func HandleListInferenceRulesSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/role_inferences", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `
+			{
+				"role_inferences": [
+					{
+						"prior_role": {
+							"id": "a720d4",
+							"name": "operator"
+						},
+						"implies": [
+							{
+								"id": "9fe2ff",
+								"name": "member"
+							}
+						]
+					}
+				]
+			}
+		`)
+	})
+}
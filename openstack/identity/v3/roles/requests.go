@@ -16,13 +16,20 @@ type ListAssignmentsOptsBuilder interface {
 // and/or UserId to search for roles assigned to corresponding entities.
 // Effective lists effective assignments at the user, project, and domain level,
 // allowing for the effects of group membership.
+// ScopeInheritedTo set to "projects" lists only the assignments that are inherited
+// by the projects below the scope, and IncludeSubtree together with ScopeProjectId
+// includes the assignments on the project's descendants. IncludeNames adds names
+// to the assignments' entities.
 type ListAssignmentsOpts struct {
-	GroupId        string `q:"group.id"`
-	RoleId         string `q:"role.id"`
-	ScopeDomainId  string `q:"scope.domain.id"`
-	ScopeProjectId string `q:"scope.project.id"`
-	UserId         string `q:"user.id"`
-	Effective      bool   `q:"effective"`
+	GroupId          string `q:"group.id"`
+	RoleId           string `q:"role.id"`
+	ScopeDomainId    string `q:"scope.domain.id"`
+	ScopeProjectId   string `q:"scope.project.id"`
+	ScopeInheritedTo string `q:"scope.OS-INHERIT:inherited_to"`
+	UserId           string `q:"user.id"`
+	Effective        bool   `q:"effective"`
+	IncludeSubtree   bool   `q:"include_subtree"`
+	IncludeNames     bool   `q:"include_names"`
 }
 
 // ToRolesListAssignmentsQuery formats a ListAssignmentsOpts into a query string.
@@ -48,3 +55,247 @@ func ListAssignments(client *gophercloud.ServiceClient, opts ListAssignmentsOpts
 
 	return pagination.NewPager(client, url, createPage)
 }
+
+// ListOptsBuilder allows extensions to add additional parameters to the List request.
+type ListOptsBuilder interface {
+	ToRoleListQuery() (string, error)
+}
+
+// ListOpts allows you to filter the roles returned by the List method.
+type ListOpts struct {
+	// DomainID limits the results to the roles that are specific to a domain. Global roles are
+	// listed when it's empty.
+	DomainID string `q:"domain_id"`
+
+	// Name limits the results to the role with the given name.
+	Name string `q:"name"`
+}
+
+// ToRoleListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToRoleListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), nil
+}
+
+// List enumerates the roles that can be assigned.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(client)
+	if opts != nil {
+		query, err := opts.ToRoleListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+
+	createPage := func(r pagination.PageResult) pagination.Page {
+		return RolePage{pagination.LinkedPageBase{PageResult: r}}
+	}
+
+	return pagination.NewPager(client, url, createPage)
+}
+
+// Get returns additional information about a role, given its ID.
+func Get(client *gophercloud.ServiceClient, roleID string) GetResult {
+	var result GetResult
+	_, result.Err = client.Get(roleURL(client, roleID), &result.Body, nil)
+	return result
+}
+
+// CreateOptsBuilder describes struct types that can be accepted by the Create call.
+type CreateOptsBuilder interface {
+	ToRoleCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts contains the attributes of a new role.
+type CreateOpts struct {
+	// Name is the name of the role. It's required, and must be unique among the global roles or
+	// among the roles of its domain.
+	Name string
+
+	// DomainID makes the role specific to a domain. Domain-specific roles can only be assigned
+	// within their domain, and are typically used as prior roles that imply global ones.
+	DomainID string
+
+	// Description describes the role.
+	Description string
+}
+
+// ToRoleCreateMap assembles a request body based on the contents of a CreateOpts.
+func (opts CreateOpts) ToRoleCreateMap() (map[string]interface{}, error) {
+	if opts.Name == "" {
+		return nil, ErrNameRequired
+	}
+
+	r := map[string]interface{}{"name": opts.Name}
+	if opts.DomainID != "" {
+		r["domain_id"] = opts.DomainID
+	}
+	if opts.Description != "" {
+		r["description"] = opts.Description
+	}
+
+	return map[string]interface{}{"role": r}, nil
+}
+
+// Create adds a new role.
+func Create(client *gophercloud.ServiceClient, opts CreateOptsBuilder) CreateResult {
+	var result CreateResult
+
+	reqBody, err := opts.ToRoleCreateMap()
+	if err != nil {
+		result.Err = err
+		return result
+	}
+
+	_, result.Err = client.Post(listURL(client), reqBody, &result.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return result
+}
+
+// UpdateOptsBuilder allows extensions to add additional attributes to the Update request.
+type UpdateOptsBuilder interface {
+	ToRoleUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts contains the attributes of a role that may be changed. Attributes left empty are not
+// changed. A role can't be moved to a different domain.
+type UpdateOpts struct {
+	Name        string
+	Description string
+}
+
+// ToRoleUpdateMap assembles a request body based on the contents of an UpdateOpts.
+func (opts UpdateOpts) ToRoleUpdateMap() (map[string]interface{}, error) {
+	r := make(map[string]interface{})
+	if opts.Name != "" {
+		r["name"] = opts.Name
+	}
+	if opts.Description != "" {
+		r["description"] = opts.Description
+	}
+
+	return map[string]interface{}{"role": r}, nil
+}
+
+// Update changes the attributes of an existing role.
+func Update(client *gophercloud.ServiceClient, roleID string, opts UpdateOptsBuilder) UpdateResult {
+	var result UpdateResult
+
+	reqBody, err := opts.ToRoleUpdateMap()
+	if err != nil {
+		result.Err = err
+		return result
+	}
+
+	_, result.Err = client.Request("PATCH", roleURL(client, roleID), gophercloud.RequestOpts{
+		JSONBody:     reqBody,
+		JSONResponse: &result.Body,
+		OkCodes:      []int{200},
+	})
+	return result
+}
+
+// Delete removes a role, along with all of its assignments.
+func Delete(client *gophercloud.ServiceClient, roleID string) DeleteResult {
+	var result DeleteResult
+	_, result.Err = client.Delete(roleURL(client, roleID), nil)
+	return result
+}
+
+// ListAll returns the roles that match opts.
+func ListAll(client *gophercloud.ServiceClient, opts ListOptsBuilder) ([]Role, error) {
+	pages, err := List(client, opts).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	return ExtractRoles(pages)
+}
+
+// AssignOpts identifies the actor and the target of a role assignment. Exactly one of UserID and
+// GroupID, and exactly one of ProjectID and DomainID, must be provided.
+type AssignOpts struct {
+	UserID    string
+	GroupID   string
+	ProjectID string
+	DomainID  string
+
+	// Inherited makes the assignment apply to the projects below the target instead of to the
+	// target itself, using the OS-INHERIT extension. The target may be a domain, whose projects all
+	// inherit the role, or a project, whose subtree inherits it.
+	Inherited bool
+}
+
+// Assign grants a role to a user or group on a project or domain.
+func Assign(client *gophercloud.ServiceClient, roleID string, opts AssignOpts) AssignmentResult {
+	var result AssignmentResult
+
+	url, err := assignmentURL(client, roleID, opts)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+
+	_, result.Err = client.Put(url, nil, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return result
+}
+
+// Unassign revokes a role from a user or group on a project or domain. The opts must match those
+// that the role was assigned with, including Inherited.
+func Unassign(client *gophercloud.ServiceClient, roleID string, opts AssignOpts) AssignmentResult {
+	var result AssignmentResult
+
+	url, err := assignmentURL(client, roleID, opts)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+
+	_, result.Err = client.Delete(url, nil)
+	return result
+}
+
+// CreateImpliedRole makes a prior role imply another role, so that users who are assigned the prior
+// role are also given the implied one.
+func CreateImpliedRole(client *gophercloud.ServiceClient, priorRoleID, impliedRoleID string) ImpliedRoleResult {
+	var result ImpliedRoleResult
+	_, result.Err = client.Put(impliedRoleURL(client, priorRoleID, impliedRoleID), nil, &result.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return result
+}
+
+// GetImpliedRole retrieves the inference rule that a prior role implies another role. The call
+// fails with a *gophercloud.NotFoundError if there's no such rule.
+func GetImpliedRole(client *gophercloud.ServiceClient, priorRoleID, impliedRoleID string) ImpliedRoleResult {
+	var result ImpliedRoleResult
+	_, result.Err = client.Get(impliedRoleURL(client, priorRoleID, impliedRoleID), &result.Body, nil)
+	return result
+}
+
+// DeleteImpliedRole removes the inference rule that a prior role implies another role.
+func DeleteImpliedRole(client *gophercloud.ServiceClient, priorRoleID, impliedRoleID string) DeleteResult {
+	var result DeleteResult
+	_, result.Err = client.Delete(impliedRoleURL(client, priorRoleID, impliedRoleID), nil)
+	return result
+}
+
+// ListImpliedRoles retrieves the roles that a prior role implies.
+func ListImpliedRoles(client *gophercloud.ServiceClient, priorRoleID string) RoleInferenceResult {
+	var result RoleInferenceResult
+	_, result.Err = client.Get(listImpliedRolesURL(client, priorRoleID), &result.Body, nil)
+	return result
+}
+
+// ListInferenceRules retrieves every inference rule, grouped by prior role.
+func ListInferenceRules(client *gophercloud.ServiceClient) RoleInferencesResult {
+	var result RoleInferencesResult
+	_, result.Err = client.Get(listInferenceRulesURL(client), &result.Body, nil)
+	return result
+}
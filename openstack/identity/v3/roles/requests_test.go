@@ -102,3 +102,113 @@ func TestListSinglePage(t *testing.T) {
 		t.Errorf("Expected 1 page, got %d", count)
 	}
 }
+
+func TestList(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+	HandleListRolesSuccessfully(t)
+
+	actual, err := ListAll(client.ServiceClient(), nil)
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckDeepEquals(t, []Role{MemberRole, OperatorRole}, actual)
+}
+
+func TestGet(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+	HandleGetRoleSuccessfully(t)
+
+	actual, err := Get(client.ServiceClient(), "a720d4").Extract()
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckDeepEquals(t, OperatorRole, *actual)
+}
+
+func TestCreate(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+	HandleCreateRoleSuccessfully(t)
+
+	opts := CreateOpts{Name: "operator", DomainID: "1789d1", Description: "Operates the domain's services"}
+	actual, err := Create(client.ServiceClient(), opts).Extract()
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckDeepEquals(t, OperatorRole, *actual)
+}
+
+func TestUpdate(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+	HandleUpdateRoleSuccessfully(t)
+
+	actual, err := Update(client.ServiceClient(), "a720d4", UpdateOpts{Description: "Operates the domain's services"}).Extract()
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckDeepEquals(t, OperatorRole, *actual)
+}
+
+func TestDelete(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+	HandleDeleteRoleSuccessfully(t)
+
+	testhelper.AssertNoErr(t, Delete(client.ServiceClient(), "a720d4").ExtractErr())
+}
+
+func TestAssign(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+	HandleAssignmentSuccessfully(t, "/projects/263fd9/users/0ca8f6/roles/a720d4")
+	HandleAssignmentSuccessfully(t, "/domains/1789d1/groups/9fe1d3/roles/a720d4")
+
+	opts := AssignOpts{UserID: "0ca8f6", ProjectID: "263fd9"}
+	testhelper.AssertNoErr(t, Assign(client.ServiceClient(), "a720d4", opts).ExtractErr())
+	testhelper.AssertNoErr(t, Unassign(client.ServiceClient(), "a720d4", opts).ExtractErr())
+
+	opts = AssignOpts{GroupID: "9fe1d3", DomainID: "1789d1"}
+	testhelper.AssertNoErr(t, Assign(client.ServiceClient(), "a720d4", opts).ExtractErr())
+}
+
+func TestAssignInherited(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+	HandleAssignmentSuccessfully(t, "/OS-INHERIT/domains/1789d1/groups/9fe1d3/roles/a720d4/inherited_to_projects")
+
+	opts := AssignOpts{GroupID: "9fe1d3", DomainID: "1789d1", Inherited: true}
+	testhelper.AssertNoErr(t, Assign(client.ServiceClient(), "a720d4", opts).ExtractErr())
+	testhelper.AssertNoErr(t, Unassign(client.ServiceClient(), "a720d4", opts).ExtractErr())
+}
+
+func TestAssignRequiresActorAndTarget(t *testing.T) {
+	err := Assign(client.ServiceClient(), "a720d4", AssignOpts{UserID: "0ca8f6", GroupID: "9fe1d3", ProjectID: "263fd9"}).ExtractErr()
+	testhelper.CheckEquals(t, ErrActorRequired, err)
+
+	err = Unassign(client.ServiceClient(), "a720d4", AssignOpts{UserID: "0ca8f6"}).ExtractErr()
+	testhelper.CheckEquals(t, ErrTargetRequired, err)
+}
+
+func TestImpliedRoles(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+	HandleImpliedRoleSuccessfully(t)
+	HandleListImpliedRolesSuccessfully(t)
+	HandleListInferenceRulesSuccessfully(t)
+
+	operator := Role{ID: "a720d4", Name: "operator"}
+	member := Role{ID: "9fe2ff", Name: "member"}
+
+	implication, err := CreateImpliedRole(client.ServiceClient(), "a720d4", "9fe2ff").Extract()
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckDeepEquals(t, RoleImplication{PriorRole: operator, Implies: member}, *implication)
+
+	implication, err = GetImpliedRole(client.ServiceClient(), "a720d4", "9fe2ff").Extract()
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckDeepEquals(t, RoleImplication{PriorRole: operator, Implies: member}, *implication)
+
+	inference, err := ListImpliedRoles(client.ServiceClient(), "a720d4").Extract()
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckDeepEquals(t, RoleInference{PriorRole: operator, Implies: []Role{member}}, *inference)
+
+	rules, err := ListInferenceRules(client.ServiceClient()).Extract()
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckDeepEquals(t, []RoleInference{{PriorRole: operator, Implies: []Role{member}}}, rules)
+
+	testhelper.AssertNoErr(t, DeleteImpliedRole(client.ServiceClient(), "a720d4", "9fe2ff").ExtractErr())
+}
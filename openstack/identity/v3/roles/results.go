@@ -1,6 +1,7 @@
 package roles
 
 import (
+	"github.com/rackspace/gophercloud"
 	"github.com/rackspace/gophercloud/pagination"
 
 	"github.com/mitchellh/mapstructure"
@@ -14,13 +15,28 @@ type RoleAssignment struct {
 	Group Group `json:"group,omitempty"`
 }
 
+// Role is a set of permissions that can be assigned to users and groups. Only its ID is set in
+// a RoleAssignment, unless its names were requested with IncludeNames.
 type Role struct {
 	ID string `json:"id,omitempty"`
+
+	// Name is the name of the role.
+	Name string `mapstructure:"name"`
+
+	// DomainID is the ID of the domain that the role is specific to, or "" for a global role.
+	DomainID string `mapstructure:"domain_id"`
+
+	// Description describes the role.
+	Description string `mapstructure:"description"`
 }
 
 type Scope struct {
 	Domain  Domain  `json:"domain,omitempty"`
-	Project Project `json:"domain,omitempty"`
+	Project Project `json:"project,omitempty"`
+
+	// InheritedTo is "projects" if the assignment is inherited by the projects below its scope
+	// rather than applying to the scope itself.
+	InheritedTo string `mapstructure:"OS-INHERIT:inherited_to"`
 }
 
 type Domain struct {
@@ -79,3 +95,149 @@ func ExtractRoleAssignments(page pagination.Page) ([]RoleAssignment, error) {
 	err := mapstructure.Decode(page.(RoleAssignmentsPage).Body, &response)
 	return response.RoleAssignments, err
 }
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a GetResult, CreateResult or UpdateResult as a concrete Role.
+// An error is returned if the original call or the extraction failed.
+func (r commonResult) Extract() (*Role, error) {
+	if r.Err != nil {
+		return nil, r.Err
+	}
+
+	var res struct {
+		Role Role `mapstructure:"role"`
+	}
+
+	err := mapstructure.Decode(r.Body, &res)
+
+	return &res.Role, err
+}
+
+// CreateResult is the deferred result of a Create call.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult is the deferred result of a Get call.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult is the deferred result of an Update call.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult is the deferred result of a Delete or DeleteImpliedRole call.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// AssignmentResult is the deferred result of an Assign or Unassign call.
+type AssignmentResult struct {
+	gophercloud.ErrResult
+}
+
+// RolePage is a single page of Role results.
+type RolePage struct {
+	pagination.LinkedPageBase
+}
+
+// CollectionKey returns "roles".
+func (page RolePage) CollectionKey() string {
+	return "roles"
+}
+
+// IsEmpty returns true if the page contains no results.
+func (page RolePage) IsEmpty() (bool, error) {
+	roles, err := ExtractRoles(page)
+	if err != nil {
+		return true, err
+	}
+	return len(roles) == 0, nil
+}
+
+// ExtractRoles extracts a slice of Roles from a page acquired from List.
+func ExtractRoles(page pagination.Page) ([]Role, error) {
+	var response struct {
+		Roles []Role `mapstructure:"roles"`
+	}
+
+	err := mapstructure.Decode(page.(RolePage).Body, &response)
+	return response.Roles, err
+}
+
+// RoleImplication is an inference rule that a prior role implies another role.
+type RoleImplication struct {
+	PriorRole Role `mapstructure:"prior_role"`
+	Implies   Role `mapstructure:"implies"`
+}
+
+// ImpliedRoleResult is the deferred result of a CreateImpliedRole or GetImpliedRole call.
+type ImpliedRoleResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets an ImpliedRoleResult as a RoleImplication.
+func (r ImpliedRoleResult) Extract() (*RoleImplication, error) {
+	if r.Err != nil {
+		return nil, r.Err
+	}
+
+	var res struct {
+		RoleInference RoleImplication `mapstructure:"role_inference"`
+	}
+
+	err := mapstructure.Decode(r.Body, &res)
+
+	return &res.RoleInference, err
+}
+
+// RoleInference lists the roles that a prior role implies.
+type RoleInference struct {
+	PriorRole Role   `mapstructure:"prior_role"`
+	Implies   []Role `mapstructure:"implies"`
+}
+
+// RoleInferenceResult is the deferred result of a ListImpliedRoles call.
+type RoleInferenceResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a RoleInferenceResult as a RoleInference.
+func (r RoleInferenceResult) Extract() (*RoleInference, error) {
+	if r.Err != nil {
+		return nil, r.Err
+	}
+
+	var res struct {
+		RoleInference RoleInference `mapstructure:"role_inference"`
+	}
+
+	err := mapstructure.Decode(r.Body, &res)
+
+	return &res.RoleInference, err
+}
+
+// RoleInferencesResult is the deferred result of a ListInferenceRules call.
+type RoleInferencesResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a RoleInferencesResult as a slice of RoleInferences, one per prior role.
+func (r RoleInferencesResult) Extract() ([]RoleInference, error) {
+	if r.Err != nil {
+		return nil, r.Err
+	}
+
+	var res struct {
+		RoleInferences []RoleInference `mapstructure:"role_inferences"`
+	}
+
+	err := mapstructure.Decode(r.Body, &res)
+
+	return res.RoleInferences, err
+}
@@ -5,3 +5,52 @@ import "github.com/rackspace/gophercloud"
 func listAssignmentsURL(client *gophercloud.ServiceClient) string {
 	return client.ServiceURL("role_assignments")
 }
+
+func listURL(client *gophercloud.ServiceClient) string {
+	return client.ServiceURL("roles")
+}
+
+func roleURL(client *gophercloud.ServiceClient, roleID string) string {
+	return client.ServiceURL("roles", roleID)
+}
+
+func impliedRoleURL(client *gophercloud.ServiceClient, priorRoleID, impliedRoleID string) string {
+	return client.ServiceURL("roles", priorRoleID, "implies", impliedRoleID)
+}
+
+func listImpliedRolesURL(client *gophercloud.ServiceClient, priorRoleID string) string {
+	return client.ServiceURL("roles", priorRoleID, "implies")
+}
+
+func listInferenceRulesURL(client *gophercloud.ServiceClient) string {
+	return client.ServiceURL("role_inferences")
+}
+
+// assignmentURL builds the URL of a role assignment, e.g. projects/{id}/users/{id}/roles/{id}, or
+// OS-INHERIT/projects/{id}/users/{id}/roles/{id}/inherited_to_projects for an inherited one.
+func assignmentURL(client *gophercloud.ServiceClient, roleID string, opts AssignOpts) (string, error) {
+	var actorType, actorID string
+	switch {
+	case opts.UserID != "" && opts.GroupID == "":
+		actorType, actorID = "users", opts.UserID
+	case opts.GroupID != "" && opts.UserID == "":
+		actorType, actorID = "groups", opts.GroupID
+	default:
+		return "", ErrActorRequired
+	}
+
+	var targetType, targetID string
+	switch {
+	case opts.ProjectID != "" && opts.DomainID == "":
+		targetType, targetID = "projects", opts.ProjectID
+	case opts.DomainID != "" && opts.ProjectID == "":
+		targetType, targetID = "domains", opts.DomainID
+	default:
+		return "", ErrTargetRequired
+	}
+
+	if opts.Inherited {
+		return client.ServiceURL("OS-INHERIT", targetType, targetID, actorType, actorID, "roles", roleID, "inherited_to_projects"), nil
+	}
+	return client.ServiceURL(targetType, targetID, actorType, actorID, "roles", roleID), nil
+}
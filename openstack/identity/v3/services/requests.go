@@ -75,3 +75,12 @@ func Delete(client *gophercloud.ServiceClient, serviceID string) DeleteResult {
 	_, res.Err = client.Delete(serviceURL(client, serviceID), nil)
 	return res
 }
+
+// ListAll returns the services in the catalog that match opts.
+func ListAll(client *gophercloud.ServiceClient, opts ListOpts) ([]Service, error) {
+	pages, err := List(client, opts).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	return ExtractServices(pages)
+}
@@ -60,6 +60,11 @@ type ServicePage struct {
 	pagination.LinkedPageBase
 }
 
+// CollectionKey returns "services".
+func (page ServicePage) CollectionKey() string {
+	return "services"
+}
+
 // IsEmpty returns true if the page contains no results.
 func (p ServicePage) IsEmpty() (bool, error) {
 	services, err := ExtractServices(p)
@@ -1,6 +1,23 @@
 // Package tokens provides information and interaction with the token API
 // resource for the OpenStack Identity service.
 //
+// Users of the OS-FEDERATION extension authenticate through their identity
+// provider for an unscoped token, and then exchange it for a scoped one:
+//
+//   result := tokens.CreateFederated(client, tokens.FederatedAuthOpts{
+//     IdentityProvider: "corp-oidc",
+//     Protocol:         "openid",
+//     AccessToken:      accessToken,
+//   })
+//   unscoped, err := result.ExtractToken()
+//
+//   client.ProviderClient.SetToken(unscoped.ID)
+//   pages, err := tokens.ListAvailableProjects(client).AllPages()
+//   available, err := projects.ExtractProjects(pages)
+//
+//   scoped, err := tokens.Rescope(client, unscoped.ID, tokens.Scope{ProjectID: available[0].ID}).ExtractToken()
+//   client.ProviderClient.SetToken(scoped.ID)
+//
 // For more information, see:
 // http://developer.openstack.org/api-ref-identity-v3.html#tokens-v3
 package tokens
@@ -52,8 +52,20 @@ var (
 	// It may also indicate that both a DomainID and a DomainName were provided at once.
 	ErrDomainIDOrDomainName = errors.New("You must provide exactly one of DomainID or DomainName to authenticate by Username")
 
-	// ErrMissingPassword indicates that no password and no token were provided and no token is available.
-	ErrMissingPassword = errors.New("You must provide a password or a token to authenticate")
+	// ErrMissingPassword indicates that no password, passcode or token were provided and no token is available.
+	ErrMissingPassword = errors.New("You must provide a password, a passcode or a token to authenticate")
+
+	// ErrApplicationCredentialSecret indicates that an application credential was identified without its secret.
+	ErrApplicationCredentialSecret = errors.New("You must provide an ApplicationCredentialSecret to authenticate with an application credential")
+
+	// ErrApplicationCredentialIDOrName indicates that both an ID and a name were provided for an application credential.
+	ErrApplicationCredentialIDOrName = errors.New("You must provide at most one of ApplicationCredentialID or ApplicationCredentialName")
+
+	// ErrApplicationCredentialWithOtherMethod indicates that an application credential was combined with another authentication method.
+	ErrApplicationCredentialWithOtherMethod = errors.New("A Password, Passcode or TokenID may not be provided when authenticating with an application credential")
+
+	// ErrScopeWithApplicationCredential indicates that a Scope was provided with an application credential, which is scoped already.
+	ErrScopeWithApplicationCredential = errors.New("A Scope may not be provided when authenticating with an application credential")
 
 	// ErrScopeDomainIDOrDomainName indicates that a domain ID or Name was required in a Scope, but not present.
 	ErrScopeDomainIDOrDomainName = errors.New("You must provide exactly one of DomainID or DomainName in a Scope with ProjectName")
@@ -69,4 +81,10 @@ var (
 
 	// ErrScopeEmpty indicates that no credentials were provided in a Scope.
 	ErrScopeEmpty = errors.New("You must provide either a Project or Domain in a Scope")
+
+	// ErrFederatedIdentityProvider indicates that federated authentication was attempted without an identity provider.
+	ErrFederatedIdentityProvider = errors.New("You must provide an IdentityProvider to authenticate a federated user")
+
+	// ErrFederatedProtocol indicates that federated authentication was attempted without a protocol.
+	ErrFederatedProtocol = errors.New("You must provide a Protocol to authenticate a federated user")
 )
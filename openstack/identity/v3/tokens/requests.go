@@ -4,6 +4,9 @@ import (
 	"net/http"
 
 	"github.com/rackspace/gophercloud"
+	"github.com/rackspace/gophercloud/openstack/identity/v3/domains"
+	"github.com/rackspace/gophercloud/openstack/identity/v3/projects"
+	"github.com/rackspace/gophercloud/pagination"
 )
 
 // Scope allows a created token to be limited to a specific domain or project.
@@ -52,111 +55,15 @@ func (options AuthOptions) ToAuthOptionsV3Map(c *gophercloud.ServiceClient, scop
 		return nil, ErrTenantNameProvided
 	}
 
-	if options.Password == "" {
-		if options.TokenID != "" {
-			c.TokenID = options.TokenID
-		}
-		if c.TokenID != "" {
-			// Because we aren't using password authentication, it's an error to also provide any of the user-based authentication
-			// parameters.
-			if options.Username != "" {
-				return nil, ErrUsernameWithToken
-			}
-			if options.UserID != "" {
-				return nil, ErrUserIDWithToken
-			}
-
-			// Configure the request for Token authentication.
-                        authMap["identity"] = map[string]interface{}{
-                                "methods": []string{"token"},
-                                "token": map[string]interface{}{
-                                        "id": c.TokenID,
-				},
-			}
-
-		} else {
-			// If no password or token ID are available, authentication can't continue.
-			return nil, ErrMissingPassword
-		}
-	} else {
-		// Password authentication.
-
-		// At least one of Username and UserID must be specified.
-		if options.Username == "" && options.UserID == "" {
-			return nil, ErrUsernameOrUserID
-		}
-
-		if options.Username != "" {
-			// If Username is provided, UserID may not be provided.
-			if options.UserID != "" {
-				return nil, ErrUsernameOrUserID
-			}
-
-			// Either DomainID or DomainName must also be specified.
-			if options.DomainID == "" && options.DomainName == "" {
-				return nil, ErrDomainIDOrDomainName
-			}
-
-			if options.DomainID != "" {
-				if options.DomainName != "" {
-					return nil, ErrDomainIDOrDomainName
-				}
-
-				// Configure the request for Username and Password authentication with a DomainID.
-                                authMap["identity"] = map[string]interface{}{
-					"methods": []string{"password"},
-                                	"password" : map[string]interface{}{
-                                                "user": map[string]interface{}{
-                                                        "name": &options.Username,
-                                                        "password": options.Password,
-                                                        "domain": map[string]interface{}{
-                                                                "id": &options.DomainID,
-                                                        },
-                                                },
-					},
-				}
-
-			}
-
-			if options.DomainName != "" {
-				// Configure the request for Username and Password authentication with a DomainName.
-                                authMap["identity"] = map[string]interface{}{
-					"methods": []string{"password"},
-                                        "password": map[string]interface{}{
-                                                 "user": map[string]interface{}{
-                                                         "name": &options.Username,
-                                                         "password": options.Password,
-                                                         "domain": map[string]interface{}{
-                                                                 "name": &options.DomainName,
-                                                         },
-                                                },
-                                         },
-                                 }
-
-			}
-		}
-
-		if options.UserID != "" {
-			// If UserID is specified, neither DomainID nor DomainName may be.
-			if options.DomainID != "" {
-				return nil, ErrDomainIDWithUserID
-			}
-			if options.DomainName != "" {
-				return nil, ErrDomainNameWithUserID
-			}
-
-			// Configure the request for UserID and Password authentication.
-                        authMap["identity"] = map[string]interface{}{
-				"methods": []string{"password"},
-                                "password" : map[string]interface{}{
-                                        "user": map[string]interface{}{
-                                                "id": &options.UserID,
-                                                "password": options.Password,
-                                        },
-                                },
-			}
+	identity, err := options.identityMap(c)
+	if err != nil {
+		return nil, err
+	}
+	authMap["identity"] = identity
 
-		}
+	// Application credentials are bound to a project already.
+	if scope != nil && options.usesApplicationCredential() {
+		return nil, ErrScopeWithApplicationCredential
 	}
 
 	// Add a "scope" element if a Scope has been provided.
@@ -230,6 +137,158 @@ func (options AuthOptions) ToAuthOptionsV3Map(c *gophercloud.ServiceClient, scop
 	return map[string]interface{}{"auth": authMap}, nil
 }
 
+// usesApplicationCredential reports whether the options identify an application credential.
+func (options AuthOptions) usesApplicationCredential() bool {
+	return options.ApplicationCredentialID != "" || options.ApplicationCredentialName != ""
+}
+
+// identityMap assembles the "identity" element of the Create request body. Application
+// credentials are used if they're provided; otherwise the password and passcode methods are used
+// together or alone, and if neither is available, the token that the client holds is exchanged
+// for a new one.
+func (options AuthOptions) identityMap(c *gophercloud.ServiceClient) (map[string]interface{}, error) {
+	if options.usesApplicationCredential() {
+		return options.applicationCredentialMap()
+	}
+
+	passcode := options.Passcode
+	if passcode == "" && options.PasscodeFunc != nil {
+		var err error
+		if passcode, err = options.PasscodeFunc(); err != nil {
+			return nil, err
+		}
+	}
+
+	if options.Password == "" && passcode == "" {
+		if options.TokenID != "" {
+			c.SetToken(options.TokenID)
+		}
+		if c.Token() == "" {
+			// If no password, passcode or token ID are available, authentication can't continue.
+			return nil, ErrMissingPassword
+		}
+
+		// Because we aren't using password authentication, it's an error to also provide any of the user-based authentication
+		// parameters.
+		if options.Username != "" {
+			return nil, ErrUsernameWithToken
+		}
+		if options.UserID != "" {
+			return nil, ErrUserIDWithToken
+		}
+
+		// Configure the request for Token authentication.
+		return map[string]interface{}{
+			"methods": []string{"token"},
+			"token": map[string]interface{}{
+				"id": c.Token(),
+			},
+		}, nil
+	}
+
+	// Multi-factor authentication sends each factor as a method of its own, all of which must
+	// succeed.
+	var methods []string
+	identity := make(map[string]interface{})
+
+	if options.Password != "" {
+		user, err := options.userMap()
+		if err != nil {
+			return nil, err
+		}
+		user["password"] = options.Password
+		methods = append(methods, "password")
+		identity["password"] = map[string]interface{}{"user": user}
+	}
+
+	if passcode != "" {
+		user, err := options.userMap()
+		if err != nil {
+			return nil, err
+		}
+		user["passcode"] = passcode
+		methods = append(methods, "totp")
+		identity["totp"] = map[string]interface{}{"user": user}
+	}
+
+	identity["methods"] = methods
+	return identity, nil
+}
+
+// userMap identifies the user to authenticate as, either by UserID or by Username within a domain.
+func (options AuthOptions) userMap() (map[string]interface{}, error) {
+	// Exactly one of Username and UserID must be specified.
+	if options.Username == "" && options.UserID == "" {
+		return nil, ErrUsernameOrUserID
+	}
+
+	if options.Username != "" {
+		if options.UserID != "" {
+			return nil, ErrUsernameOrUserID
+		}
+
+		// Exactly one of DomainID and DomainName must also be specified.
+		if options.DomainID == "" && options.DomainName == "" {
+			return nil, ErrDomainIDOrDomainName
+		}
+		if options.DomainID != "" && options.DomainName != "" {
+			return nil, ErrDomainIDOrDomainName
+		}
+
+		domain := map[string]interface{}{"id": options.DomainID}
+		if options.DomainName != "" {
+			domain = map[string]interface{}{"name": options.DomainName}
+		}
+		return map[string]interface{}{
+			"name":   options.Username,
+			"domain": domain,
+		}, nil
+	}
+
+	// If UserID is specified, neither DomainID nor DomainName may be.
+	if options.DomainID != "" {
+		return nil, ErrDomainIDWithUserID
+	}
+	if options.DomainName != "" {
+		return nil, ErrDomainNameWithUserID
+	}
+	return map[string]interface{}{"id": options.UserID}, nil
+}
+
+// applicationCredentialMap assembles the "identity" element for authentication with an
+// application credential, identified either by its ID alone or by its name and owner.
+func (options AuthOptions) applicationCredentialMap() (map[string]interface{}, error) {
+	if options.Password != "" || options.Passcode != "" || options.PasscodeFunc != nil || options.TokenID != "" {
+		return nil, ErrApplicationCredentialWithOtherMethod
+	}
+	if options.ApplicationCredentialSecret == "" {
+		return nil, ErrApplicationCredentialSecret
+	}
+
+	credential := map[string]interface{}{
+		"secret": options.ApplicationCredentialSecret,
+	}
+	if options.ApplicationCredentialID != "" {
+		if options.ApplicationCredentialName != "" {
+			return nil, ErrApplicationCredentialIDOrName
+		}
+		credential["id"] = options.ApplicationCredentialID
+	} else {
+		// A name is only unique among the credentials of its user.
+		user, err := options.userMap()
+		if err != nil {
+			return nil, err
+		}
+		credential["name"] = options.ApplicationCredentialName
+		credential["user"] = user
+	}
+
+	return map[string]interface{}{
+		"methods":                []string{"application_credential"},
+		"application_credential": credential,
+	}, nil
+}
+
 // Create authenticates and either generates a new token, or changes the Scope of an existing token.
 func Create(c *gophercloud.ServiceClient, options AuthOptionsV3er, scope *Scope) CreateResult {
         request, err := options.ToAuthOptionsV3Map(c, scope)
@@ -283,3 +342,82 @@ func Revoke(c *gophercloud.ServiceClient, token string) RevokeResult {
 	})
 	return res
 }
+
+// FederatedAuthOpts identifies the identity provider and protocol that a federated user
+// authenticates through, and carries the user's credentials for them.
+type FederatedAuthOpts struct {
+	// IdentityProvider and Protocol are the IDs of the identity provider and of its protocol, such
+	// as "saml2" or "openid". Both are required.
+	IdentityProvider string
+	Protocol         string
+
+	// AccessToken is an OpenID Connect access token issued by the identity provider, which is sent
+	// as a bearer token.
+	AccessToken string
+
+	// Headers are sent along with the request, e.g. to carry a session cookie from a SAML login or
+	// attributes asserted by a trusted proxy.
+	Headers map[string]string
+}
+
+// CreateFederated authenticates a federated user and generates an unscoped token for them. The
+// token can be exchanged for a scoped token with Rescope, once ListAvailableProjects or
+// ListAvailableDomains has found a scope for it.
+func CreateFederated(c *gophercloud.ServiceClient, opts FederatedAuthOpts) CreateResult {
+	if opts.IdentityProvider == "" {
+		return createErr(ErrFederatedIdentityProvider)
+	}
+	if opts.Protocol == "" {
+		return createErr(ErrFederatedProtocol)
+	}
+
+	headers := make(map[string]string, len(opts.Headers)+1)
+	for k, v := range opts.Headers {
+		headers[k] = v
+	}
+	if opts.AccessToken != "" {
+		headers["Authorization"] = "Bearer " + opts.AccessToken
+	}
+
+	var result CreateResult
+	var response *http.Response
+	response, result.Err = c.Request("GET", federatedAuthURL(c, opts.IdentityProvider, opts.Protocol), gophercloud.RequestOpts{
+		JSONResponse: &result.Body,
+		MoreHeaders:  headers,
+		OkCodes:      []int{200, 201},
+	})
+	if result.Err != nil {
+		return result
+	}
+	result.Header = response.Header
+	return result
+}
+
+// Rescope exchanges a token, such as an unscoped federated token, for a new token limited to the
+// given Scope. The client is left authenticating with tokenID; to use the new token instead, pass
+// the extracted token's ID to the ProviderClient's SetToken.
+func Rescope(c *gophercloud.ServiceClient, tokenID string, scope Scope) CreateResult {
+	options := AuthOptions{gophercloud.AuthOptions{TokenID: tokenID}}
+	return Create(c, options, &scope)
+}
+
+// ListAvailableProjects enumerates the projects that the client's token may be scoped to, which
+// for an unscoped federated token are those that the federated user's groups have roles on.
+// Extract them with projects.ExtractProjects.
+func ListAvailableProjects(c *gophercloud.ServiceClient) pagination.Pager {
+	createPage := func(r pagination.PageResult) pagination.Page {
+		return projects.ProjectPage{LinkedPageBase: pagination.LinkedPageBase{PageResult: r}}
+	}
+
+	return pagination.NewPager(c, availableProjectsURL(c), createPage)
+}
+
+// ListAvailableDomains enumerates the domains that the client's token may be scoped to. Extract
+// them with domains.ExtractDomains.
+func ListAvailableDomains(c *gophercloud.ServiceClient) pagination.Pager {
+	createPage := func(r pagination.PageResult) pagination.Page {
+		return domains.DomainPage{LinkedPageBase: pagination.LinkedPageBase{PageResult: r}}
+	}
+
+	return pagination.NewPager(c, availableDomainsURL(c), createPage)
+}
@@ -1,12 +1,16 @@
 package tokens
 
 import (
+	"bytes"
 	"fmt"
+	"log"
 	"net/http"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/rackspace/gophercloud"
+	"github.com/rackspace/gophercloud/openstack/identity/v3/projects"
 	"github.com/rackspace/gophercloud/testhelper"
 )
 
@@ -287,6 +291,112 @@ func TestCreateExtractsTokenFromResponse(t *testing.T) {
 	}
 }
 
+func TestCreateApplicationCredentialID(t *testing.T) {
+	authTokenPost(t, gophercloud.AuthOptions{ApplicationCredentialID: "ac1", ApplicationCredentialSecret: "s3cr3t"}, nil, `
+		{
+			"auth": {
+				"identity": {
+					"methods": ["application_credential"],
+					"application_credential": {
+						"id": "ac1",
+						"secret": "s3cr3t"
+					}
+				}
+			}
+		}
+	`)
+}
+
+func TestCreateApplicationCredentialName(t *testing.T) {
+	options := gophercloud.AuthOptions{
+		Username:                    "frank",
+		DomainName:                  "spork.net",
+		ApplicationCredentialName:   "deployer",
+		ApplicationCredentialSecret: "s3cr3t",
+	}
+	authTokenPost(t, options, nil, `
+		{
+			"auth": {
+				"identity": {
+					"methods": ["application_credential"],
+					"application_credential": {
+						"name": "deployer",
+						"secret": "s3cr3t",
+						"user": {
+							"name": "frank",
+							"domain": { "name": "spork.net" }
+						}
+					}
+				}
+			}
+		}
+	`)
+}
+
+func TestCreatePasscode(t *testing.T) {
+	authTokenPost(t, gophercloud.AuthOptions{UserID: "me", Passcode: "123456"}, nil, `
+		{
+			"auth": {
+				"identity": {
+					"methods": ["totp"],
+					"totp": {
+						"user": { "id": "me", "passcode": "123456" }
+					}
+				}
+			}
+		}
+	`)
+}
+
+func TestCreatePasswordAndPasscode(t *testing.T) {
+	options := gophercloud.AuthOptions{
+		Username: "fakey",
+		DomainID: "abc123",
+		Password: "notpassword",
+		PasscodeFunc: func() (string, error) {
+			return "654321", nil
+		},
+	}
+	authTokenPost(t, options, &Scope{ProjectID: "123456"}, `
+		{
+			"auth": {
+				"identity": {
+					"methods": ["password", "totp"],
+					"password": {
+						"user": {
+							"name": "fakey",
+							"domain": { "id": "abc123" },
+							"password": "notpassword"
+						}
+					},
+					"totp": {
+						"user": {
+							"name": "fakey",
+							"domain": { "id": "abc123" },
+							"passcode": "654321"
+						}
+					}
+				},
+				"scope": {
+					"project": { "id": "123456" }
+				}
+			}
+		}
+	`)
+}
+
+func TestCreateFailureApplicationCredential(t *testing.T) {
+	authTokenPostErr(t, gophercloud.AuthOptions{ApplicationCredentialID: "ac1"}, nil, false, ErrApplicationCredentialSecret)
+	authTokenPostErr(t, gophercloud.AuthOptions{ApplicationCredentialID: "ac1", ApplicationCredentialName: "deployer", ApplicationCredentialSecret: "s"}, nil, false, ErrApplicationCredentialIDOrName)
+	authTokenPostErr(t, gophercloud.AuthOptions{ApplicationCredentialID: "ac1", ApplicationCredentialSecret: "s", Password: "p"}, nil, false, ErrApplicationCredentialWithOtherMethod)
+	authTokenPostErr(t, gophercloud.AuthOptions{ApplicationCredentialName: "deployer", ApplicationCredentialSecret: "s"}, nil, false, ErrUsernameOrUserID)
+	authTokenPostErr(t, gophercloud.AuthOptions{ApplicationCredentialID: "ac1", ApplicationCredentialSecret: "s"}, &Scope{ProjectID: "123456"}, false, ErrScopeWithApplicationCredential)
+}
+
+func TestCreateFailurePasscodeWithoutUser(t *testing.T) {
+	authTokenPostErr(t, gophercloud.AuthOptions{Passcode: "123456"}, nil, false, ErrUsernameOrUserID)
+}
+
 func TestCreateFailureEmptyAuth(t *testing.T) {
 	authTokenPostErr(t, gophercloud.AuthOptions{}, nil, false, ErrMissingPassword)
 }
@@ -569,3 +679,157 @@ func TestRevokeRequestError(t *testing.T) {
 		t.Errorf("Missing expected error from Revoke")
 	}
 }
+
+func TestCreateFederated(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+
+	client := gophercloud.ServiceClient{
+		ProviderClient: &gophercloud.ProviderClient{},
+		Endpoint:       testhelper.Endpoint(),
+	}
+
+	testhelper.Mux.HandleFunc("/OS-FEDERATION/identity_providers/corp-oidc/protocols/openid/auth", func(w http.ResponseWriter, r *http.Request) {
+		testhelper.TestMethod(t, r, "GET")
+		testhelper.TestHeader(t, r, "Authorization", "Bearer oidc-access-token")
+		testhelper.TestHeader(t, r, "X-Auth-Token", "")
+
+		w.Header().Add("X-Subject-Token", "unscoped")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{
+			"token": {
+				"expires_at": "2014-10-02T13:45:00.000000Z",
+				"methods": ["openid"],
+				"user": {
+					"id": "8a1c2e",
+					"name": "jdoe",
+					"OS-FEDERATION": {
+						"identity_provider": {"id": "corp-oidc"},
+						"protocol": {"id": "openid"},
+						"groups": [{"id": "0cd5e9"}]
+					}
+				}
+			}
+		}`)
+	})
+
+	opts := FederatedAuthOpts{IdentityProvider: "corp-oidc", Protocol: "openid", AccessToken: "oidc-access-token"}
+	token, err := CreateFederated(&client, opts).ExtractToken()
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckEquals(t, "unscoped", token.ID)
+}
+
+func TestCreateFederatedIsRedactedByDebugLogger(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+
+	var buf bytes.Buffer
+	client := gophercloud.ServiceClient{
+		ProviderClient: &gophercloud.ProviderClient{
+			Interceptors: []gophercloud.Interceptor{&gophercloud.DebugLogger{Logger: log.New(&buf, "", 0)}},
+		},
+		Endpoint: testhelper.Endpoint(),
+	}
+
+	testhelper.Mux.HandleFunc("/OS-FEDERATION/identity_providers/corp-saml/protocols/saml2/auth", func(w http.ResponseWriter, r *http.Request) {
+		testhelper.TestHeader(t, r, "Authorization", "Bearer oidc-access-token")
+		testhelper.TestHeader(t, r, "Cookie", "_shibsession_abc=shib-session-id")
+
+		w.Header().Add("Content-Type", "application/json")
+		w.Header().Add("Set-Cookie", "keystone-session=keystone-session-id")
+		w.Header().Add("X-Subject-Token", "unscoped-token-id")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{"token": {"expires_at": "2014-10-02T13:45:00.000000Z", "methods": ["saml2"]}}`)
+	})
+
+	opts := FederatedAuthOpts{
+		IdentityProvider: "corp-saml",
+		Protocol:         "saml2",
+		AccessToken:      "oidc-access-token",
+		Headers:          map[string]string{"Cookie": "_shibsession_abc=shib-session-id"},
+	}
+	_, err := CreateFederated(&client, opts).ExtractToken()
+	testhelper.AssertNoErr(t, err)
+
+	out := buf.String()
+	for _, secret := range []string{"oidc-access-token", "shib-session-id", "keystone-session-id", "unscoped-token-id"} {
+		if strings.Contains(out, secret) {
+			t.Errorf("Expected %q to be redacted from the log, but got:\n%s", secret, out)
+		}
+	}
+	for _, expected := range []string{"Authorization: ***", "Cookie: ***", "Set-Cookie: ***"} {
+		if !strings.Contains(out, expected) {
+			t.Errorf("Expected %q in the log, but got:\n%s", expected, out)
+		}
+	}
+}
+
+func TestCreateFederatedRequiresIdentityProviderAndProtocol(t *testing.T) {
+	client := gophercloud.ServiceClient{ProviderClient: &gophercloud.ProviderClient{}}
+
+	_, err := CreateFederated(&client, FederatedAuthOpts{Protocol: "openid"}).ExtractToken()
+	testhelper.CheckEquals(t, ErrFederatedIdentityProvider, err)
+
+	_, err = CreateFederated(&client, FederatedAuthOpts{IdentityProvider: "corp-oidc"}).ExtractToken()
+	testhelper.CheckEquals(t, ErrFederatedProtocol, err)
+}
+
+func TestRescope(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+
+	client := gophercloud.ServiceClient{
+		ProviderClient: &gophercloud.ProviderClient{},
+		Endpoint:       testhelper.Endpoint(),
+	}
+
+	testhelper.Mux.HandleFunc("/auth/projects", func(w http.ResponseWriter, r *http.Request) {
+		testhelper.TestMethod(t, r, "GET")
+		testhelper.TestHeader(t, r, "X-Auth-Token", "unscoped")
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{
+			"links": {"next": null},
+			"projects": [{"id": "263fd9", "name": "web", "domain_id": "1789d1", "enabled": true}]
+		}`)
+	})
+
+	testhelper.Mux.HandleFunc("/auth/tokens", func(w http.ResponseWriter, r *http.Request) {
+		testhelper.TestMethod(t, r, "POST")
+		testhelper.TestJSONRequest(t, r, `
+			{
+				"auth": {
+					"identity": {
+						"methods": ["token"],
+						"token": { "id": "unscoped" }
+					},
+					"scope": {
+						"project": { "id": "263fd9" }
+					}
+				}
+			}
+		`)
+
+		w.Header().Add("X-Subject-Token", "scoped")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{
+			"token": {
+				"expires_at": "2014-10-02T13:45:00.000000Z"
+			}
+		}`)
+	})
+
+	client.ProviderClient.SetToken("unscoped")
+	pages, err := ListAvailableProjects(&client).AllPages()
+	testhelper.AssertNoErr(t, err)
+	available, err := projects.ExtractProjects(pages)
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckEquals(t, 1, len(available))
+
+	token, err := Rescope(&client, "unscoped", Scope{ProjectID: available[0].ID}).ExtractToken()
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckEquals(t, "scoped", token.ID)
+
+	// The client keeps the token it was rescoped from until it's told otherwise.
+	testhelper.CheckEquals(t, "unscoped", client.ProviderClient.Token())
+}
@@ -5,3 +5,15 @@ import "github.com/rackspace/gophercloud"
 func tokenURL(c *gophercloud.ServiceClient) string {
 	return c.ServiceURL("auth", "tokens")
 }
+
+func federatedAuthURL(c *gophercloud.ServiceClient, idpID, protocolID string) string {
+	return c.ServiceURL("OS-FEDERATION", "identity_providers", idpID, "protocols", protocolID, "auth")
+}
+
+func availableProjectsURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL("auth", "projects")
+}
+
+func availableDomainsURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL("auth", "domains")
+}
@@ -0,0 +1,4 @@
+// Package users provides information and interaction with the users API
+// resource for the OpenStack Identity service, including changing passwords
+// and managing the users' membership of groups.
+package users
@@ -0,0 +1,251 @@
+// +build fixtures
+
+package users
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/rackspace/gophercloud/testhelper"
+	fake "github.com/rackspace/gophercloud/testhelper/client"
+)
+
+// UserOutput is a user as the service returns it.
+const UserOutput = `
+{
+	"user": {
+		"id": "0ca8f6",
+		"name": "jsmith",
+		"domain_id": "1789d1",
+		"default_project_id": "263fd9",
+		"description": "James Smith",
+		"enabled": true,
+		"password_expires_at": "2016-11-06T15:32:17.000000",
+		"email": "jsmith@example.com",
+		"links": {
+			"self": "http://localhost:5000/v3/users/0ca8f6"
+		}
+	}
+}
+`
+
+// ListOutput is a page of users as the service returns it.
+const ListOutput = `
+{
+	"links": {
+		"next": null,
+		"previous": null
+	},
+	"users": [
+		{
+			"id": "0ca8f6",
+			"name": "jsmith",
+			"domain_id": "1789d1",
+			"default_project_id": "263fd9",
+			"description": "James Smith",
+			"enabled": true,
+			"password_expires_at": "2016-11-06T15:32:17.000000",
+			"email": "jsmith@example.com"
+		},
+		{
+			"id": "9fe1d3",
+			"name": "svc-backup",
+			"domain_id": "1789d1",
+			"enabled": false,
+			"password_expires_at": null
+		}
+	]
+}
+`
+
+// JSmith is the expected result of extracting UserOutput.
+var JSmith = User{
+	ID:                "0ca8f6",
+	Name:              "jsmith",
+	DomainID:          "1789d1",
+	DefaultProjectID:  "263fd9",
+	Description:       "James Smith",
+	Enabled:           true,
+	PasswordExpiresAt: "2016-11-06T15:32:17.000000",
+	Extra:             map[string]interface{}{"email": "jsmith@example.com"},
+}
+
+// BackupUser is the second user in ListOutput.
+var BackupUser = User{
+	ID:       "9fe1d3",
+	Name:     "svc-backup",
+	DomainID: "1789d1",
+}
+
+// HandleListUsersSuccessfully sets up the test server to respond to a List request.
+func HandleListUsersSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/users", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"domain_id": "1789d1"})
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, ListOutput)
+	})
+}
+
+// HandleGetUserSuccessfully sets up the test server to respond to a Get request.
+func HandleGetUserSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/users/0ca8f6", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, UserOutput)
+	})
+}
+
+// HandleCreateUserSuccessfully sets up the test server to respond to a Create request.
+func HandleCreateUserSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/users", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, `
+			{
+				"user": {
+					"name": "jsmith",
+					"domain_id": "1789d1",
+					"default_project_id": "263fd9",
+					"description": "James Smith",
+					"password": "secretsecret",
+					"email": "jsmith@example.com"
+				}
+			}
+		`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, UserOutput)
+	})
+}
+
+// HandleUpdateUserSuccessfully sets up the test server to respond to an Update request.
+func HandleUpdateUserSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/users/0ca8f6", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PATCH")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, `{ "user": { "enabled": true, "email": "jsmith@example.com" } }`)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, UserOutput)
+	})
+}
+
+// HandleDeleteUserSuccessfully sets up the test server to respond to a Delete request.
+func HandleDeleteUserSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/users/0ca8f6", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// HandleChangePasswordSuccessfully sets up the test server to respond to a ChangePassword request.
+func HandleChangePasswordSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/users/0ca8f6/password", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, `
+			{
+				"user": {
+					"original_password": "secretsecret",
+					"password": "n3wsecret"
+				}
+			}
+		`)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// HandleListGroupsSuccessfully sets up the test server to respond to a ListGroups request.
+func HandleListGroupsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/users/0ca8f6/groups", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `
+			{
+				"links": {
+					"next": null,
+					"previous": null
+				},
+				"groups": [
+					{
+						"id": "9fe1d3",
+						"name": "support",
+						"description": "Support personnel",
+						"domain_id": "1789d1"
+					}
+				]
+			}
+		`)
+	})
+}
+
+// HandleListProjectsSuccessfully sets up the test server to respond to a ListProjects request.
+func HandleListProjectsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/users/0ca8f6/projects", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `
+			{
+				"links": {
+					"next": null,
+					"previous": null
+				},
+				"projects": [
+					{
+						"id": "263fd9",
+						"name": "web",
+						"domain_id": "1789d1",
+						"parent_id": "1789d1",
+						"enabled": true
+					}
+				]
+			}
+		`)
+	})
+}
+
+// HandleListInGroupSuccessfully sets up the test server to respond to a ListInGroup request.
+func HandleListInGroupSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/groups/9fe1d3/users", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, ListOutput)
+	})
+}
+
+// HandleGroupMembershipSuccessfully sets up the test server to respond to AddToGroup,
+// RemoveFromGroup and IsMemberOfGroup requests for the user 0ca8f6, who is a member of the group,
+// and for the user 9fe1d3, who isn't.
+func HandleGroupMembershipSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/groups/9fe1d3/users/0ca8f6", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		switch r.Method {
+		case "PUT", "DELETE", "HEAD":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	})
+	th.Mux.HandleFunc("/groups/9fe1d3/users/9fe1d3", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "HEAD")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.WriteHeader(http.StatusNotFound)
+	})
+}
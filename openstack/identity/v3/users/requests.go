@@ -0,0 +1,308 @@
+package users
+
+import (
+	"errors"
+
+	"github.com/rackspace/gophercloud"
+	"github.com/rackspace/gophercloud/openstack/identity/v3/groups"
+	"github.com/rackspace/gophercloud/openstack/identity/v3/projects"
+	"github.com/rackspace/gophercloud/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the List and ListInGroup
+// requests.
+type ListOptsBuilder interface {
+	ToUserListQuery() (string, error)
+}
+
+// ListOpts allows you to filter the users returned by the List and ListInGroup methods.
+type ListOpts struct {
+	// DomainID limits the results to the users owned by a domain.
+	DomainID string `q:"domain_id"`
+
+	// Name limits the results to the user with the given name.
+	Name string `q:"name"`
+
+	// Enabled limits the results to enabled or disabled users.
+	Enabled *bool `q:"enabled"`
+}
+
+// ToUserListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToUserListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), nil
+}
+
+func listUsers(client *gophercloud.ServiceClient, url string, opts ListOptsBuilder) pagination.Pager {
+	if opts != nil {
+		query, err := opts.ToUserListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+
+	createPage := func(r pagination.PageResult) pagination.Page {
+		return UserPage{pagination.LinkedPageBase{PageResult: r}}
+	}
+
+	return pagination.NewPager(client, url, createPage)
+}
+
+// List enumerates the users that the current user is allowed to see.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	return listUsers(client, listURL(client), opts)
+}
+
+// Get returns additional information about a user, given its ID.
+func Get(client *gophercloud.ServiceClient, userID string) GetResult {
+	var result GetResult
+	_, result.Err = client.Get(userURL(client, userID), &result.Body, nil)
+	return result
+}
+
+// CreateOptsBuilder describes struct types that can be accepted by the Create call.
+type CreateOptsBuilder interface {
+	ToUserCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts contains the attributes of a new user.
+type CreateOpts struct {
+	// Name is the name of the user. It's required, and must be unique within its domain.
+	Name string
+
+	// DomainID is the ID of the domain that owns the user. It defaults to the domain of the
+	// current token.
+	DomainID string
+
+	// DefaultProjectID is the project that the user's tokens are scoped to when they don't request
+	// a scope.
+	DefaultProjectID string
+
+	// Description describes the user.
+	Description string
+
+	// Enabled determines whether the user is enabled. Users are enabled by default.
+	Enabled gophercloud.EnabledState
+
+	// Password is the user's initial password.
+	Password string
+
+	// Extra holds any additional attributes to store with the user, such as "email".
+	Extra map[string]interface{}
+}
+
+// ToUserCreateMap assembles a request body based on the contents of a CreateOpts.
+func (opts CreateOpts) ToUserCreateMap() (map[string]interface{}, error) {
+	if opts.Name == "" {
+		return nil, errors.New("A Name must be provided")
+	}
+
+	u := userMap(opts.Extra)
+	u["name"] = opts.Name
+	if opts.DomainID != "" {
+		u["domain_id"] = opts.DomainID
+	}
+	if opts.DefaultProjectID != "" {
+		u["default_project_id"] = opts.DefaultProjectID
+	}
+	if opts.Description != "" {
+		u["description"] = opts.Description
+	}
+	if opts.Enabled != nil {
+		u["enabled"] = *opts.Enabled
+	}
+	if opts.Password != "" {
+		u["password"] = opts.Password
+	}
+
+	return map[string]interface{}{"user": u}, nil
+}
+
+// Create adds a new user.
+func Create(client *gophercloud.ServiceClient, opts CreateOptsBuilder) CreateResult {
+	var result CreateResult
+
+	reqBody, err := opts.ToUserCreateMap()
+	if err != nil {
+		result.Err = err
+		return result
+	}
+
+	_, result.Err = client.Post(listURL(client), reqBody, &result.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return result
+}
+
+// UpdateOptsBuilder allows extensions to add additional attributes to the Update request.
+type UpdateOptsBuilder interface {
+	ToUserUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts contains the attributes of a user that may be changed. Attributes left empty are not
+// changed.
+type UpdateOpts struct {
+	Name             string
+	DefaultProjectID string
+	Description      string
+	Enabled          gophercloud.EnabledState
+
+	// Password resets the user's password without requiring the original one, which needs
+	// administrative rights. Users change their own passwords with ChangePassword.
+	Password string
+
+	// Extra sets additional attributes of the user, such as "email".
+	Extra map[string]interface{}
+}
+
+// ToUserUpdateMap assembles a request body based on the contents of an UpdateOpts.
+func (opts UpdateOpts) ToUserUpdateMap() (map[string]interface{}, error) {
+	u := userMap(opts.Extra)
+	if opts.Name != "" {
+		u["name"] = opts.Name
+	}
+	if opts.DefaultProjectID != "" {
+		u["default_project_id"] = opts.DefaultProjectID
+	}
+	if opts.Description != "" {
+		u["description"] = opts.Description
+	}
+	if opts.Enabled != nil {
+		u["enabled"] = *opts.Enabled
+	}
+	if opts.Password != "" {
+		u["password"] = opts.Password
+	}
+
+	return map[string]interface{}{"user": u}, nil
+}
+
+// userMap starts a request body from a user's extra attributes, which the service stores
+// alongside the ones it knows about.
+func userMap(extra map[string]interface{}) map[string]interface{} {
+	u := make(map[string]interface{}, len(extra))
+	for k, v := range extra {
+		u[k] = v
+	}
+	return u
+}
+
+// Update changes the attributes of an existing user.
+func Update(client *gophercloud.ServiceClient, userID string, opts UpdateOptsBuilder) UpdateResult {
+	var result UpdateResult
+
+	reqBody, err := opts.ToUserUpdateMap()
+	if err != nil {
+		result.Err = err
+		return result
+	}
+
+	_, result.Err = client.Request("PATCH", userURL(client, userID), gophercloud.RequestOpts{
+		JSONBody:     reqBody,
+		JSONResponse: &result.Body,
+		OkCodes:      []int{200},
+	})
+	return result
+}
+
+// Delete removes a user.
+func Delete(client *gophercloud.ServiceClient, userID string) DeleteResult {
+	var result DeleteResult
+	_, result.Err = client.Delete(userURL(client, userID), nil)
+	return result
+}
+
+// ChangePasswordOpts contains a user's current and new passwords.
+type ChangePasswordOpts struct {
+	OriginalPassword string
+	Password         string
+}
+
+// ChangePassword changes a user's own password. Both the original and the new password are
+// required. Tokens issued to the user are revoked by the change.
+func ChangePassword(client *gophercloud.ServiceClient, userID string, opts ChangePasswordOpts) ChangePasswordResult {
+	var result ChangePasswordResult
+
+	if opts.OriginalPassword == "" || opts.Password == "" {
+		result.Err = errors.New("Both the OriginalPassword and the new Password must be provided")
+		return result
+	}
+
+	reqBody := map[string]interface{}{
+		"user": map[string]interface{}{
+			"original_password": opts.OriginalPassword,
+			"password":          opts.Password,
+		},
+	}
+
+	_, result.Err = client.Post(changePasswordURL(client, userID), reqBody, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return result
+}
+
+// ListGroups enumerates the groups that a user is a member of. Extract them with
+// groups.ExtractGroups.
+func ListGroups(client *gophercloud.ServiceClient, userID string) pagination.Pager {
+	createPage := func(r pagination.PageResult) pagination.Page {
+		return groups.GroupPage{LinkedPageBase: pagination.LinkedPageBase{PageResult: r}}
+	}
+
+	return pagination.NewPager(client, listGroupsURL(client, userID), createPage)
+}
+
+// ListProjects enumerates the projects that a user has been granted roles on. Extract them with
+// projects.ExtractProjects.
+func ListProjects(client *gophercloud.ServiceClient, userID string) pagination.Pager {
+	createPage := func(r pagination.PageResult) pagination.Page {
+		return projects.ProjectPage{LinkedPageBase: pagination.LinkedPageBase{PageResult: r}}
+	}
+
+	return pagination.NewPager(client, listProjectsURL(client, userID), createPage)
+}
+
+// ListInGroup enumerates the members of a group.
+func ListInGroup(client *gophercloud.ServiceClient, groupID string, opts ListOptsBuilder) pagination.Pager {
+	return listUsers(client, listInGroupURL(client, groupID), opts)
+}
+
+// AddToGroup makes a user a member of a group.
+func AddToGroup(client *gophercloud.ServiceClient, groupID, userID string) MembershipResult {
+	var result MembershipResult
+	_, result.Err = client.Put(membershipURL(client, groupID, userID), nil, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return result
+}
+
+// RemoveFromGroup removes a user from a group.
+func RemoveFromGroup(client *gophercloud.ServiceClient, groupID, userID string) MembershipResult {
+	var result MembershipResult
+	_, result.Err = client.Delete(membershipURL(client, groupID, userID), nil)
+	return result
+}
+
+// IsMemberOfGroup reports whether a user is a member of a group.
+func IsMemberOfGroup(client *gophercloud.ServiceClient, groupID, userID string) (bool, error) {
+	response, err := client.Request("HEAD", membershipURL(client, groupID, userID), gophercloud.RequestOpts{
+		OkCodes: []int{204, 404},
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return response.StatusCode == 204, nil
+}
+
+// ListAll returns the users that match opts.
+func ListAll(client *gophercloud.ServiceClient, opts ListOptsBuilder) ([]User, error) {
+	pages, err := List(client, opts).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	return ExtractUsers(pages)
+}
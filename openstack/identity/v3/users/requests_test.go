@@ -0,0 +1,197 @@
+package users
+
+import (
+	"bytes"
+	"log"
+	"strings"
+	"testing"
+
+	"github.com/rackspace/gophercloud"
+	"github.com/rackspace/gophercloud/openstack/identity/v3/groups"
+	"github.com/rackspace/gophercloud/openstack/identity/v3/projects"
+	"github.com/rackspace/gophercloud/pagination"
+	th "github.com/rackspace/gophercloud/testhelper"
+	"github.com/rackspace/gophercloud/testhelper/client"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListUsersSuccessfully(t)
+
+	count := 0
+	err := List(client.ServiceClient(), ListOpts{DomainID: "1789d1"}).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		actual, err := ExtractUsers(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, []User{JSmith, BackupUser}, actual)
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 1, count)
+}
+
+func TestListAll(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListUsersSuccessfully(t)
+
+	actual, err := ListAll(client.ServiceClient(), ListOpts{DomainID: "1789d1"})
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []User{JSmith, BackupUser}, actual)
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetUserSuccessfully(t)
+
+	actual, err := Get(client.ServiceClient(), "0ca8f6").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, JSmith, *actual)
+}
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateUserSuccessfully(t)
+
+	opts := CreateOpts{
+		Name:             "jsmith",
+		DomainID:         "1789d1",
+		DefaultProjectID: "263fd9",
+		Description:      "James Smith",
+		Password:         "secretsecret",
+		Extra:            map[string]interface{}{"email": "jsmith@example.com"},
+	}
+	actual, err := Create(client.ServiceClient(), opts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, JSmith, *actual)
+}
+
+func TestUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdateUserSuccessfully(t)
+
+	enabled := true
+	opts := UpdateOpts{
+		Enabled: &enabled,
+		Extra:   map[string]interface{}{"email": "jsmith@example.com"},
+	}
+	actual, err := Update(client.ServiceClient(), "0ca8f6", opts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, JSmith, *actual)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteUserSuccessfully(t)
+
+	err := Delete(client.ServiceClient(), "0ca8f6").ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestChangePassword(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleChangePasswordSuccessfully(t)
+
+	opts := ChangePasswordOpts{OriginalPassword: "secretsecret", Password: "n3wsecret"}
+	err := ChangePassword(client.ServiceClient(), "0ca8f6", opts).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestChangePasswordIsRedactedByDebugLogger(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleChangePasswordSuccessfully(t)
+
+	var buf bytes.Buffer
+	sc := client.ServiceClient()
+	sc.Interceptors = []gophercloud.Interceptor{&gophercloud.DebugLogger{Logger: log.New(&buf, "", 0)}}
+
+	opts := ChangePasswordOpts{OriginalPassword: "secretsecret", Password: "n3wsecret"}
+	err := ChangePassword(sc, "0ca8f6", opts).ExtractErr()
+	th.AssertNoErr(t, err)
+
+	out := buf.String()
+	for _, secret := range []string{"secretsecret", "n3wsecret"} {
+		if strings.Contains(out, secret) {
+			t.Errorf("Expected %q to be redacted from the log, but got:\n%s", secret, out)
+		}
+	}
+	if !strings.Contains(out, `{"user":{"original_password":"***","password":"***"}}`) {
+		t.Errorf("Expected both passwords to be redacted in the log, but got:\n%s", out)
+	}
+}
+
+func TestChangePasswordRequiresBothPasswords(t *testing.T) {
+	err := ChangePassword(client.ServiceClient(), "0ca8f6", ChangePasswordOpts{Password: "n3wsecret"}).ExtractErr()
+	if err == nil {
+		t.Fatalf("Expected an error without the original password")
+	}
+}
+
+func TestListGroups(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListGroupsSuccessfully(t)
+
+	pages, err := ListGroups(client.ServiceClient(), "0ca8f6").AllPages()
+	th.AssertNoErr(t, err)
+	actual, err := groups.ExtractGroups(pages)
+	th.AssertNoErr(t, err)
+
+	expected := []groups.Group{
+		{ID: "9fe1d3", Name: "support", Description: "Support personnel", DomainID: "1789d1"},
+	}
+	th.CheckDeepEquals(t, expected, actual)
+}
+
+func TestListProjects(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListProjectsSuccessfully(t)
+
+	pages, err := ListProjects(client.ServiceClient(), "0ca8f6").AllPages()
+	th.AssertNoErr(t, err)
+	actual, err := projects.ExtractProjects(pages)
+	th.AssertNoErr(t, err)
+
+	expected := []projects.Project{
+		{ID: "263fd9", Name: "web", DomainID: "1789d1", ParentID: "1789d1", Enabled: true},
+	}
+	th.CheckDeepEquals(t, expected, actual)
+}
+
+func TestListInGroup(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListInGroupSuccessfully(t)
+
+	pages, err := ListInGroup(client.ServiceClient(), "9fe1d3", nil).AllPages()
+	th.AssertNoErr(t, err)
+	actual, err := ExtractUsers(pages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []User{JSmith, BackupUser}, actual)
+}
+
+func TestGroupMembership(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGroupMembershipSuccessfully(t)
+
+	th.AssertNoErr(t, AddToGroup(client.ServiceClient(), "9fe1d3", "0ca8f6").ExtractErr())
+
+	member, err := IsMemberOfGroup(client.ServiceClient(), "9fe1d3", "0ca8f6")
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, true, member)
+
+	member, err = IsMemberOfGroup(client.ServiceClient(), "9fe1d3", "9fe1d3")
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, false, member)
+
+	th.AssertNoErr(t, RemoveFromGroup(client.ServiceClient(), "9fe1d3", "0ca8f6").ExtractErr())
+}
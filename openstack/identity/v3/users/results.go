@@ -0,0 +1,162 @@
+package users
+
+import (
+	"github.com/rackspace/gophercloud"
+	"github.com/rackspace/gophercloud/pagination"
+
+	"github.com/mitchellh/mapstructure"
+)
+
+// User is an identity that can authenticate and be granted roles.
+type User struct {
+	// ID is the unique ID of the user.
+	ID string `mapstructure:"id"`
+
+	// Name is the name of the user, which is unique within its domain.
+	Name string `mapstructure:"name"`
+
+	// DomainID is the ID of the domain that owns the user.
+	DomainID string `mapstructure:"domain_id"`
+
+	// DefaultProjectID is the project that the user's tokens are scoped to when they don't request
+	// a scope.
+	DefaultProjectID string `mapstructure:"default_project_id"`
+
+	// Description describes the user.
+	Description string `mapstructure:"description"`
+
+	// Enabled is false if the user has been disabled, in which case they can't authenticate.
+	Enabled bool `mapstructure:"enabled"`
+
+	// PasswordExpiresAt is the time at which the user's password expires, or "" if it doesn't.
+	PasswordExpiresAt string `mapstructure:"password_expires_at"`
+
+	// Extra holds the additional attributes stored with the user, such as "email".
+	Extra map[string]interface{} `mapstructure:"-"`
+}
+
+// knownAttributes are the attributes of a user that aren't collected into its Extra.
+var knownAttributes = map[string]bool{
+	"id":                  true,
+	"name":                true,
+	"domain_id":           true,
+	"default_project_id":  true,
+	"description":         true,
+	"enabled":             true,
+	"password_expires_at": true,
+	"links":               true,
+	"options":             true,
+}
+
+// decodeUser decodes a user from the body of a response, collecting its additional attributes
+// into Extra.
+func decodeUser(body interface{}) (User, error) {
+	var user User
+	if err := mapstructure.Decode(body, &user); err != nil {
+		return user, err
+	}
+
+	if attributes, ok := body.(map[string]interface{}); ok {
+		for k, v := range attributes {
+			if knownAttributes[k] {
+				continue
+			}
+			if user.Extra == nil {
+				user.Extra = make(map[string]interface{})
+			}
+			user.Extra[k] = v
+		}
+	}
+	return user, nil
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a GetResult, CreateResult or UpdateResult as a concrete User.
+// An error is returned if the original call or the extraction failed.
+func (r commonResult) Extract() (*User, error) {
+	if r.Err != nil {
+		return nil, r.Err
+	}
+
+	var res struct {
+		User interface{} `mapstructure:"user"`
+	}
+	if err := mapstructure.Decode(r.Body, &res); err != nil {
+		return nil, err
+	}
+
+	user, err := decodeUser(res.User)
+	return &user, err
+}
+
+// CreateResult is the deferred result of a Create call.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult is the deferred result of a Get call.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult is the deferred result of an Update call.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult is the deferred result of a Delete call.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// ChangePasswordResult is the deferred result of a ChangePassword call.
+type ChangePasswordResult struct {
+	gophercloud.ErrResult
+}
+
+// MembershipResult is the deferred result of an AddToGroup or RemoveFromGroup call.
+type MembershipResult struct {
+	gophercloud.ErrResult
+}
+
+// UserPage is a single page of User results.
+type UserPage struct {
+	pagination.LinkedPageBase
+}
+
+// CollectionKey returns "users".
+func (page UserPage) CollectionKey() string {
+	return "users"
+}
+
+// IsEmpty returns true if the page contains no results.
+func (page UserPage) IsEmpty() (bool, error) {
+	users, err := ExtractUsers(page)
+	if err != nil {
+		return true, err
+	}
+	return len(users) == 0, nil
+}
+
+// ExtractUsers extracts a slice of Users from a page acquired from List or ListInGroup.
+func ExtractUsers(page pagination.Page) ([]User, error) {
+	var response struct {
+		Users []interface{} `mapstructure:"users"`
+	}
+	if err := mapstructure.Decode(page.(UserPage).Body, &response); err != nil {
+		return nil, err
+	}
+
+	users := make([]User, 0, len(response.Users))
+	for _, body := range response.Users {
+		user, err := decodeUser(body)
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, user)
+	}
+	return users, nil
+}
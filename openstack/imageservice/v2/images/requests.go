@@ -19,6 +19,17 @@ type ListOptsBuilder interface {
 // the API. Filtering is achieved by passing in struct field values that map to
 // the server attributes you want to see returned. Marker and Limit are used
 // for pagination.
+//
+// ID, CreatedAt and UpdatedAt accept the operators that the API supports, e.g.
+// to list the images created during 2016:
+//
+//   images.ListOpts{
+//     CreatedAt: []gophercloud.QueryFilter{
+//       {Operator: gophercloud.FilterGreaterThanOrEqual, Value: start},
+//       {Operator: gophercloud.FilterLessThan, Value: end},
+//     },
+//   }
+//
 //http://developer.openstack.org/api-ref-image-v2.html
 type ListOpts struct {
 	// Integer value for the limit of values to return.
@@ -34,9 +45,18 @@ type ListOpts struct {
 	Status       ImageStatus       `q:"status"`
 	SizeMin      int64             `q:"size_min"`
 	SizeMax      int64             `q:"size_max"`
-	SortKey      string            `q:"sort_key"`
-	SortDir      string            `q:"sort_dir"`
-	Tag          string            `q:"tag"`
+
+	// Image attributes to sort by, and the direction (asc or desc) of each,
+	// paired up in order.
+	SortKey []string `q:"sort_key"`
+	SortDir []string `q:"sort_dir"`
+
+	// Images that have all of these tags.
+	Tag []string `q:"tag"`
+
+	ID        gophercloud.QueryFilter   `q:"id"`
+	CreatedAt []gophercloud.QueryFilter `q:"created_at"`
+	UpdatedAt []gophercloud.QueryFilter `q:"updated_at"`
 }
 
 // ToImageListQuery formats a ListOpts into a query string.
@@ -301,3 +321,12 @@ func Download(client *gophercloud.ServiceClient, id string) GetImageDataResult {
 
 	return res
 }
+
+// ListAll returns the images that match opts, following the "next" links of every page.
+func ListAll(c *gophercloud.ServiceClient, opts ListOptsBuilder) ([]Image, error) {
+	pages, err := List(c, opts).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	return ExtractImages(pages)
+}
@@ -4,8 +4,12 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"net/url"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/rackspace/gophercloud"
 	"github.com/rackspace/gophercloud/pagination"
 	th "github.com/rackspace/gophercloud/testhelper"
 	fakeclient "github.com/rackspace/gophercloud/testhelper/client"
@@ -46,6 +50,51 @@ func TestListImage(t *testing.T) {
 	th.AssertEquals(t, 3, count)
 }
 
+func TestListAll(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleImageListSuccessfully(t)
+
+	// Each page also holds "next", "schema" and "first" keys, which AllPages must not mistake for
+	// the collection.
+	images, err := ListAll(fakeclient.ServiceClient(), ListOpts{Limit: 1})
+	th.AssertNoErr(t, err)
+
+	var names []string
+	for _, i := range images {
+		names = append(names, i.Name)
+	}
+	th.CheckDeepEquals(t, []string{
+		"cirros-0.3.4-x86_64-uec",
+		"cirros-0.3.4-x86_64-uec-ramdisk",
+		"cirros-0.3.4-x86_64-uec-kernel",
+	}, names)
+}
+
+func TestListOptsFilters(t *testing.T) {
+	start := time.Date(2016, 1, 1, 0, 0, 0, 0, time.UTC)
+	opts := ListOpts{
+		ID: gophercloud.QueryFilter{Operator: gophercloud.FilterIn, Value: []string{"a", "b"}},
+		CreatedAt: []gophercloud.QueryFilter{
+			{Operator: gophercloud.FilterGreaterThanOrEqual, Value: start},
+			{Operator: gophercloud.FilterLessThan, Value: start.AddDate(1, 0, 0)},
+		},
+		Tag: []string{"ubuntu", "quantal"},
+	}
+
+	actual, err := opts.ToImageListQuery()
+	th.AssertNoErr(t, err)
+
+	query, err := url.ParseQuery(strings.TrimPrefix(actual, "?"))
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, url.Values{
+		"id":         {"in:a,b"},
+		"created_at": {"gte:2016-01-01T00:00:00Z", "lt:2017-01-01T00:00:00Z"},
+		"tag":        {"ubuntu", "quantal"},
+	}, query)
+}
+
 func TestCreateImage(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
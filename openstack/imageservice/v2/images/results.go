@@ -137,6 +137,11 @@ type ImagePage struct {
 	pagination.LinkedPageBase
 }
 
+// CollectionKey returns "images"; the page also holds the "schema", "first" and "next" keys.
+func (page ImagePage) CollectionKey() string {
+	return "images"
+}
+
 // IsEmpty returns true if a page contains no Images results.
 func (page ImagePage) IsEmpty() (bool, error) {
 	images, err := ExtractImages(page)
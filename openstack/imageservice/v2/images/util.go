@@ -0,0 +1,29 @@
+package images
+
+import "github.com/rackspace/gophercloud"
+
+// StatusFunc returns a gophercloud.StatusFunc that reports the status of an image, for use with a
+// gophercloud.Waiter.
+func StatusFunc(c *gophercloud.ServiceClient, id string) gophercloud.StatusFunc {
+	return func() (string, error) {
+		current, err := Get(c, id).Extract()
+		if err != nil {
+			return "", err
+		}
+		return string(current.Status), nil
+	}
+}
+
+// failureStatuses are the statuses from which an image won't reach any other status on its own.
+var failureStatuses = []string{string(ImageStatusKilled)}
+
+// WaitForStatus will continually poll an image until it successfully transitions to a specified
+// status, such as "active" once its data has been uploaded. It will do this for at most the number
+// of seconds specified, and gives up as soon as the image is killed.
+func WaitForStatus(c *gophercloud.ServiceClient, id, status string, secs int) error {
+	w := gophercloud.Waiter{
+		Target:  []string{status},
+		Failure: failureStatuses,
+	}
+	return w.WaitSeconds(c.Context, secs, StatusFunc(c, id))
+}
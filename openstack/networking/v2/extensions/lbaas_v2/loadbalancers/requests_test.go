@@ -1,6 +1,8 @@
 package loadbalancers
 
 import (
+	"fmt"
+	"net/http"
 	"testing"
 
 	fake "github.com/rackspace/gophercloud/openstack/networking/v2/common"
@@ -148,3 +150,20 @@ func TestUpdateLoadbalancer(t *testing.T) {
 
 	th.CheckDeepEquals(t, LoadbalancerUpdated, *actual)
 }
+
+func TestWaitForProvisioningStatus(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	statuses := []string{"PENDING_UPDATE", "ACTIVE"}
+	th.Mux.HandleFunc("/v2.0/lbaas/loadbalancers/36e08a3e-a78f-4b40-a229-1e7e23eee1ab", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"loadbalancer": {"id": "36e08a3e-a78f-4b40-a229-1e7e23eee1ab", "provisioning_status": "%s"}}`, statuses[0])
+		statuses = statuses[1:]
+	})
+
+	err := WaitForStatus(fake.ServiceClient(), "36e08a3e-a78f-4b40-a229-1e7e23eee1ab", "ACTIVE", 60)
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 0, len(statuses))
+}
@@ -0,0 +1,30 @@
+package loadbalancers
+
+import "github.com/rackspace/gophercloud"
+
+// StatusFunc returns a gophercloud.StatusFunc that reports the provisioning status of a load
+// balancer, for use with a gophercloud.Waiter.
+func StatusFunc(c *gophercloud.ServiceClient, id string) gophercloud.StatusFunc {
+	return func() (string, error) {
+		current, err := Get(c, id).Extract()
+		if err != nil {
+			return "", err
+		}
+		return current.ProvisioningStatus, nil
+	}
+}
+
+// failureStatuses are the statuses from which a load balancer won't reach any other status on its own.
+var failureStatuses = []string{"ERROR"}
+
+// WaitForStatus will continually poll a load balancer until its provisioning status transitions
+// to the specified one, which is usually ACTIVE once a change to the load balancer or any of its
+// listeners, pools or members has been applied. It will do this for at most the number of seconds
+// specified, and gives up as soon as the provisioning status becomes ERROR.
+func WaitForStatus(c *gophercloud.ServiceClient, id, status string, secs int) error {
+	w := gophercloud.Waiter{
+		Target:  []string{status},
+		Failure: failureStatuses,
+	}
+	return w.WaitSeconds(c.Context, secs, StatusFunc(c, id))
+}
@@ -35,20 +35,23 @@ type ListOptsBuilder interface {
 
 // ListOpts allows the filtering and sorting of paginated collections through
 // the API. Filtering is achieved by passing in struct field values that map to
-// the network attributes you want to see returned. SortKey allows you to sort
-// by a particular network attribute. SortDir sets the direction, and is either
-// `asc' or `desc'. Marker and Limit are used for pagination.
+// the network attributes you want to see returned; ID and Name match any of
+// their values. SortKey allows you to sort by network attributes, and SortDir
+// sets the direction of each, which is either `asc' or `desc'. Fields limits
+// the attributes that are returned for each network. Marker and Limit are used
+// for pagination.
 type ListOpts struct {
-	Status       string `q:"status"`
-	Name         string `q:"name"`
-	AdminStateUp *bool  `q:"admin_state_up"`
-	TenantID     string `q:"tenant_id"`
-	Shared       *bool  `q:"shared"`
-	ID           string `q:"id"`
-	Marker       string `q:"marker"`
-	Limit        int    `q:"limit"`
-	SortKey      string `q:"sort_key"`
-	SortDir      string `q:"sort_dir"`
+	Status       string   `q:"status"`
+	Name         []string `q:"name"`
+	AdminStateUp *bool    `q:"admin_state_up"`
+	TenantID     string   `q:"tenant_id"`
+	Shared       *bool    `q:"shared"`
+	ID           []string `q:"id"`
+	Marker       string   `q:"marker"`
+	Limit        int      `q:"limit"`
+	SortKey      []string `q:"sort_key"`
+	SortDir      []string `q:"sort_dir"`
+	Fields       []string `q:"fields"`
 }
 
 // ToNetworkListQuery formats a ListOpts into a query string.
@@ -224,3 +227,12 @@ func IDFromName(client *gophercloud.ServiceClient, name string) (string, error)
 		return "", fmt.Errorf("Found %d networks matching %s", networkCount, name)
 	}
 }
+
+// ListAll returns the networks that match opts, across all pages.
+func ListAll(c *gophercloud.ServiceClient, opts ListOptsBuilder) ([]Network, error) {
+	pages, err := List(c, opts).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	return ExtractNetworks(pages)
+}
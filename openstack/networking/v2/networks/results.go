@@ -76,6 +76,11 @@ type NetworkPage struct {
 	pagination.LinkedPageBase
 }
 
+// CollectionKey returns "networks", leaving out "networks_links".
+func (page NetworkPage) CollectionKey() string {
+	return "networks"
+}
+
 // NextPageURL is invoked when a paginated collection of networks has reached
 // the end of a page and the pager seeks to traverse over a new one. In order
 // to do this, it needs to construct the next page's URL.
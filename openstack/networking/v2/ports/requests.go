@@ -28,23 +28,26 @@ type ListOptsBuilder interface {
 
 // ListOpts allows the filtering and sorting of paginated collections through
 // the API. Filtering is achieved by passing in struct field values that map to
-// the port attributes you want to see returned. SortKey allows you to sort
-// by a particular port attribute. SortDir sets the direction, and is either
-// `asc' or `desc'. Marker and Limit are used for pagination.
+// the port attributes you want to see returned; ID, NetworkID and DeviceID
+// match any of their values. SortKey allows you to sort by port attributes, and
+// SortDir sets the direction of each, which is either `asc' or `desc'. Fields
+// limits the attributes that are returned for each port. Marker and Limit are
+// used for pagination.
 type ListOpts struct {
-	Status       string `q:"status"`
-	Name         string `q:"name"`
-	AdminStateUp *bool  `q:"admin_state_up"`
-	NetworkID    string `q:"network_id"`
-	TenantID     string `q:"tenant_id"`
-	DeviceOwner  string `q:"device_owner"`
-	MACAddress   string `q:"mac_address"`
-	ID           string `q:"id"`
-	DeviceID     string `q:"device_id"`
-	Limit        int    `q:"limit"`
-	Marker       string `q:"marker"`
-	SortKey      string `q:"sort_key"`
-	SortDir      string `q:"sort_dir"`
+	Status       string   `q:"status"`
+	Name         string   `q:"name"`
+	AdminStateUp *bool    `q:"admin_state_up"`
+	NetworkID    []string `q:"network_id"`
+	TenantID     string   `q:"tenant_id"`
+	DeviceOwner  string   `q:"device_owner"`
+	MACAddress   string   `q:"mac_address"`
+	ID           []string `q:"id"`
+	DeviceID     []string `q:"device_id"`
+	Limit        int      `q:"limit"`
+	Marker       string   `q:"marker"`
+	SortKey      []string `q:"sort_key"`
+	SortDir      []string `q:"sort_dir"`
+	Fields       []string `q:"fields"`
 }
 
 // ToPortListQuery formats a ListOpts into a query string.
@@ -266,3 +269,12 @@ func IDFromName(client *gophercloud.ServiceClient, name string) (string, error)
 		return "", fmt.Errorf("Found %d ports matching %s", portCount, name)
 	}
 }
+
+// ListAll returns the ports that match opts, across all pages.
+func ListAll(c *gophercloud.ServiceClient, opts ListOptsBuilder) ([]Port, error) {
+	pages, err := List(c, opts).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	return ExtractPorts(pages)
+}
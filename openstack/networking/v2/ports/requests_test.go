@@ -354,3 +354,16 @@ func TestDelete(t *testing.T) {
 	res := Delete(fake.ServiceClient(), "65c0ee9f-d634-4522-8954-51021b570b0d")
 	th.AssertNoErr(t, res.Err)
 }
+
+func TestListOptsMultipleValues(t *testing.T) {
+	opts := ListOpts{
+		NetworkID: []string{"net1", "net2"},
+		SortKey:   []string{"name", "status"},
+		SortDir:   []string{"asc", "desc"},
+		Fields:    []string{"id", "name"},
+	}
+
+	actual, err := opts.ToPortListQuery()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "?fields=id&fields=name&network_id=net1&network_id=net2&sort_dir=asc&sort_dir=desc&sort_key=name&sort_key=status", actual)
+}
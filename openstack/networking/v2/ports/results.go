@@ -92,6 +92,11 @@ type PortPage struct {
 	pagination.LinkedPageBase
 }
 
+// CollectionKey returns "ports", leaving out "ports_links".
+func (page PortPage) CollectionKey() string {
+	return "ports"
+}
+
 // NextPageURL is invoked when a paginated collection of ports has reached
 // the end of a page and the pager seeks to traverse over a new one. In order
 // to do this, it needs to construct the next page's URL.
@@ -28,22 +28,25 @@ type ListOptsBuilder interface {
 
 // ListOpts allows the filtering and sorting of paginated collections through
 // the API. Filtering is achieved by passing in struct field values that map to
-// the subnet attributes you want to see returned. SortKey allows you to sort
-// by a particular subnet attribute. SortDir sets the direction, and is either
-// `asc' or `desc'. Marker and Limit are used for pagination.
+// the subnet attributes you want to see returned; ID and NetworkID match any
+// of their values. SortKey allows you to sort by subnet attributes, and SortDir
+// sets the direction of each, which is either `asc' or `desc'. Fields limits
+// the attributes that are returned for each subnet. Marker and Limit are used
+// for pagination.
 type ListOpts struct {
-	Name       string `q:"name"`
-	EnableDHCP *bool  `q:"enable_dhcp"`
-	NetworkID  string `q:"network_id"`
-	TenantID   string `q:"tenant_id"`
-	IPVersion  int    `q:"ip_version"`
-	GatewayIP  string `q:"gateway_ip"`
-	CIDR       string `q:"cidr"`
-	ID         string `q:"id"`
-	Limit      int    `q:"limit"`
-	Marker     string `q:"marker"`
-	SortKey    string `q:"sort_key"`
-	SortDir    string `q:"sort_dir"`
+	Name       string   `q:"name"`
+	EnableDHCP *bool    `q:"enable_dhcp"`
+	NetworkID  []string `q:"network_id"`
+	TenantID   string   `q:"tenant_id"`
+	IPVersion  int      `q:"ip_version"`
+	GatewayIP  string   `q:"gateway_ip"`
+	CIDR       string   `q:"cidr"`
+	ID         []string `q:"id"`
+	Limit      int      `q:"limit"`
+	Marker     string   `q:"marker"`
+	SortKey    []string `q:"sort_key"`
+	SortDir    []string `q:"sort_dir"`
+	Fields     []string `q:"fields"`
 }
 
 // ToSubnetListQuery formats a ListOpts into a query string.
@@ -285,3 +288,12 @@ func IDFromName(client *gophercloud.ServiceClient, name string) (string, error)
 		return "", fmt.Errorf("Found %d subnets matching %s", subnetCount, name)
 	}
 }
+
+// ListAll returns the subnets that match opts, across all pages.
+func ListAll(c *gophercloud.ServiceClient, opts ListOptsBuilder) ([]Subnet, error) {
+	pages, err := List(c, opts).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	return ExtractSubnets(pages)
+}
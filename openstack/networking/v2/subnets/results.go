@@ -92,6 +92,11 @@ type SubnetPage struct {
 	pagination.LinkedPageBase
 }
 
+// CollectionKey returns "subnets", leaving out "subnets_links".
+func (page SubnetPage) CollectionKey() string {
+	return "subnets"
+}
+
 // NextPageURL is invoked when a paginated collection of subnets has reached
 // the end of a page and the pager seeks to traverse over a new one. In order
 // to do this, it needs to construct the next page's URL.
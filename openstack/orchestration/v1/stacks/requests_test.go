@@ -1,6 +1,8 @@
 package stacks
 
 import (
+	"fmt"
+	"net/http"
 	"testing"
 
 	"github.com/rackspace/gophercloud/pagination"
@@ -356,3 +358,24 @@ func TestAbandonStack(t *testing.T) {
 	expected := AbandonExpected
 	th.AssertDeepEquals(t, expected, actual)
 }
+
+func TestWaitForDeletedStack(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	polls := 0
+	th.Mux.HandleFunc("/stacks/postman_stack/16ef0584-4458-41eb-87c8-0dc8d5f66c87", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		polls++
+		if polls > 1 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"stack": {"id": "16ef0584-4458-41eb-87c8-0dc8d5f66c87", "stack_status": "DELETE_IN_PROGRESS"}}`)
+	})
+
+	err := WaitForStatus(fake.ServiceClient(), "postman_stack", "16ef0584-4458-41eb-87c8-0dc8d5f66c87", "DELETE_COMPLETE", 60)
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 2, polls)
+}
@@ -0,0 +1,47 @@
+package stacks
+
+import "github.com/rackspace/gophercloud"
+
+// StatusFunc returns a gophercloud.StatusFunc that reports the status of a stack, for use with a
+// gophercloud.Waiter.
+func StatusFunc(c *gophercloud.ServiceClient, stackName, stackID string) gophercloud.StatusFunc {
+	return func() (string, error) {
+		current, err := Get(c, stackName, stackID).Extract()
+		if err != nil {
+			return "", err
+		}
+		return current.Status, nil
+	}
+}
+
+// failureStatuses are the statuses in which a stack operation has ended without reaching the
+// status it was heading for. A failed create or update that is rolled back ends in one of the
+// ROLLBACK_COMPLETE statuses rather than in a FAILED one.
+var failureStatuses = []string{
+	"CREATE_FAILED",
+	"UPDATE_FAILED",
+	"DELETE_FAILED",
+	"ROLLBACK_FAILED",
+	"ROLLBACK_COMPLETE",
+	"UPDATE_ROLLBACK_FAILED",
+	"UPDATE_ROLLBACK_COMPLETE",
+	"SUSPEND_FAILED",
+	"RESUME_FAILED",
+	"ADOPT_FAILED",
+	"SNAPSHOT_FAILED",
+	"CHECK_FAILED",
+	"RESTORE_FAILED",
+}
+
+// WaitForStatus will continually poll a stack until it successfully transitions to a specified
+// status, such as CREATE_COMPLETE. It will do this for at most the number of seconds specified, and
+// gives up as soon as the stack operation fails or is rolled back. When waiting for
+// DELETE_COMPLETE, a stack that can no longer be found counts as deleted.
+func WaitForStatus(c *gophercloud.ServiceClient, stackName, stackID, status string, secs int) error {
+	w := gophercloud.Waiter{
+		Target:       []string{status},
+		Failure:      failureStatuses,
+		UntilDeleted: status == "DELETE_COMPLETE",
+	}
+	return w.WaitSeconds(c.Context, secs, StatusFunc(c, stackName, stackID))
+}
@@ -0,0 +1,149 @@
+package openstack
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/rackspace/gophercloud"
+	tokens2 "github.com/rackspace/gophercloud/openstack/identity/v2/tokens"
+	tokens3 "github.com/rackspace/gophercloud/openstack/identity/v3/tokens"
+)
+
+// authFunc authenticates client against the versioned identity endpoint, or the default endpoint
+// for its version if endpoint is empty.
+type authFunc func(client *gophercloud.ProviderClient, endpoint string, options gophercloud.AuthOptions) error
+
+// reauthFunc returns a ReauthFunc for client that authenticates a fresh client with auth, then
+// adopts its token. A one-time passcode can't be used twice, so if options can produce fresh ones,
+// the original is dropped.
+func reauthFunc(client *gophercloud.ProviderClient, endpoint string, options gophercloud.AuthOptions, auth authFunc) func() error {
+	if options.PasscodeFunc != nil {
+		options.Passcode = ""
+	}
+	return func() error {
+		tac := client.ReauthClient()
+		if err := auth(tac, endpoint, options); err != nil {
+			return err
+		}
+		client.CopyTokenFrom(tac)
+		return nil
+	}
+}
+
+// LoadToken adopts the token saved in options.TokenStore, if any, provided that it was issued by
+// the given identity version (any version if empty) and is still valid. Identity v3 tokens are
+// validated with the identity service; v2 tokens are trusted until they expire. It returns false
+// if client still needs to authenticate.
+//
+// If options.AllowReauth is set, client reauthenticates with auth, or if auth is nil, with this
+// package's authentication for the token's identity version. Providers that authenticate
+// differently, such as Rackspace, pass their own.
+func LoadToken(client *gophercloud.ProviderClient, options gophercloud.AuthOptions, version string,
+	auth func(client *gophercloud.ProviderClient, endpoint string, options gophercloud.AuthOptions) error) bool {
+	if options.TokenStore == nil || options.TokenID != "" {
+		return false
+	}
+
+	key := gophercloud.TokenStoreKey(client.IdentityBase, options)
+	stored, err := options.TokenStore.Load(key)
+	if err != nil || stored == nil {
+		return false
+	}
+	if version != "" && stored.IdentityVersion != version {
+		return false
+	}
+
+	// Don't bother with a token that the client would renew before its first request.
+	margin := client.TokenRenewalMargin
+	if margin == 0 {
+		margin = gophercloud.DefaultTokenRenewalMargin
+	} else if margin < 0 {
+		margin = 0
+	}
+	if !time.Now().Add(margin).Before(stored.ExpiresAt) {
+		options.TokenStore.Delete(key)
+		return false
+	}
+
+	var versionAuth authFunc
+	var locator func(gophercloud.EndpointOpts) (string, error)
+	var serviceCatalog *gophercloud.ServiceCatalog
+
+	switch stored.IdentityVersion {
+	case v20:
+		var catalog tokens2.ServiceCatalog
+		if err := json.Unmarshal(stored.Catalog, &catalog); err != nil {
+			return false
+		}
+		versionAuth = v2auth
+		locator = func(opts gophercloud.EndpointOpts) (string, error) {
+			return V2EndpointURL(&catalog, opts)
+		}
+		serviceCatalog = V2ServiceCatalog(&catalog)
+	case v30:
+		var catalog tokens3.ServiceCatalog
+		if err := json.Unmarshal(stored.Catalog, &catalog); err != nil {
+			return false
+		}
+		if !validateV3Token(client, stored) {
+			options.TokenStore.Delete(key)
+			return false
+		}
+		versionAuth = v3auth
+		locator = func(opts gophercloud.EndpointOpts) (string, error) {
+			return V3EndpointURL(&catalog, opts)
+		}
+		serviceCatalog = V3ServiceCatalog(&catalog)
+	default:
+		return false
+	}
+
+	if auth == nil {
+		auth = versionAuth
+	}
+	if options.AllowReauth {
+		client.ReauthFunc = reauthFunc(client, stored.IdentityEndpoint, options, auth)
+	}
+	client.SetToken(stored.ID)
+	client.SetTokenExpiresAt(stored.ExpiresAt)
+	client.EndpointLocator = locator
+	client.ServiceCatalog = serviceCatalog
+
+	return true
+}
+
+// validateV3Token asks the identity service whether a stored token is still valid. The request is
+// made with a separate client, so that a rejected token can't trigger client's ReauthFunc.
+func validateV3Token(client *gophercloud.ProviderClient, stored *gophercloud.StoredToken) bool {
+	vc := client.ReauthClient()
+	vc.SetToken(stored.ID)
+
+	v3Client := NewIdentityV3(vc)
+	if stored.IdentityEndpoint != "" {
+		v3Client.Endpoint = stored.IdentityEndpoint
+	}
+
+	valid, err := tokens3.Validate(v3Client, stored.ID)
+	return err == nil && valid
+}
+
+// StoreToken saves a token obtained by authenticating in options.TokenStore, if any, for LoadToken
+// to find. Failing to save it isn't an error: the next process will simply authenticate again.
+func StoreToken(client *gophercloud.ProviderClient, options gophercloud.AuthOptions, version, endpoint, id string, expiresAt time.Time, catalog interface{}) {
+	if options.TokenStore == nil || options.TokenID != "" {
+		return
+	}
+
+	b, err := json.Marshal(catalog)
+	if err != nil {
+		return
+	}
+
+	options.TokenStore.Save(gophercloud.TokenStoreKey(client.IdentityBase, options), &gophercloud.StoredToken{
+		ID:               id,
+		ExpiresAt:        expiresAt,
+		IdentityVersion:  version,
+		IdentityEndpoint: endpoint,
+		Catalog:          b,
+	})
+}
@@ -0,0 +1,148 @@
+package openstack
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/rackspace/gophercloud"
+	tokens2 "github.com/rackspace/gophercloud/openstack/identity/v2/tokens"
+	th "github.com/rackspace/gophercloud/testhelper"
+)
+
+func tempTokenStore(t *testing.T) (*gophercloud.FileTokenStore, func()) {
+	dir, err := ioutil.TempDir("", "gophercloud-tokens")
+	th.AssertNoErr(t, err)
+	return &gophercloud.FileTokenStore{Dir: dir}, func() { os.RemoveAll(dir) }
+}
+
+func TestTokenStoreReusesValidV3Token(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	discovered, issued, validated := 0, 0, 0
+	valid := ""
+
+	th.Mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		discovered++
+		fmt.Fprintf(w, `{"versions": {"values": [{"status": "stable", "id": "v3.0", "links": [{"href": "%s", "rel": "self"}]}]}}`, th.Endpoint()+"v3/")
+	})
+
+	th.Mux.HandleFunc("/v3/auth/tokens", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "POST":
+			issued++
+			valid = fmt.Sprintf("token-%d", issued)
+			w.Header().Set("X-Subject-Token", valid)
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprintf(w, `{"token": {"expires_at": "2999-02-02T18:30:59.000000Z", "catalog": [
+				{"id": "1", "name": "nova", "type": "compute", "endpoints": [
+					{"id": "2", "region": "RegionOne", "interface": "public", "url": "https://compute.example.com/v2/"}
+				]}
+			]}}`)
+		case "HEAD":
+			validated++
+			th.TestHeader(t, r, "X-Auth-Token", r.Header.Get("X-Subject-Token"))
+			if r.Header.Get("X-Subject-Token") != valid {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+
+	store, cleanup := tempTokenStore(t)
+	defer cleanup()
+
+	options := gophercloud.AuthOptions{
+		IdentityEndpoint: th.Endpoint(),
+		UserID:           "me",
+		Password:         "secret",
+		TenantID:         "project",
+		TokenStore:       store,
+	}
+
+	client, err := AuthenticatedClient(options)
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "token-1", client.Token())
+
+	// A second process finds the saved token and skips both discovery and authentication.
+	client, err = AuthenticatedClient(options)
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "token-1", client.Token())
+	th.CheckEquals(t, 1, discovered)
+	th.CheckEquals(t, 1, issued)
+	th.CheckEquals(t, 1, validated)
+
+	compute, err := NewComputeV2(client, gophercloud.EndpointOpts{Region: "RegionOne"})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "https://compute.example.com/v2/", compute.Endpoint)
+
+	// A different scope gets a token of its own.
+	other := options
+	other.TenantID = "other"
+	client, err = AuthenticatedClient(other)
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "token-2", client.Token())
+
+	// Once the identity service stops accepting the saved token, the client authenticates again.
+	client, err = AuthenticatedClient(options)
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "token-3", client.Token())
+	th.CheckEquals(t, 3, issued)
+}
+
+func TestTokenStoreSkipsExpiredV2Token(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	issued := 0
+	th.Mux.HandleFunc("/v2.0/tokens", func(w http.ResponseWriter, r *http.Request) {
+		issued++
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"access": {"token": {"id": "fresh", "expires": "2999-10-01T10:00:00.000000Z"}, "serviceCatalog": []}}`)
+	})
+
+	store, cleanup := tempTokenStore(t)
+	defer cleanup()
+
+	options := gophercloud.AuthOptions{
+		IdentityEndpoint: th.Endpoint() + "v2.0/",
+		Username:         "me",
+		Password:         "secret",
+		TokenStore:       store,
+	}
+
+	catalog, err := json.Marshal(tokens2.ServiceCatalog{})
+	th.AssertNoErr(t, err)
+	key := gophercloud.TokenStoreKey(th.Endpoint(), options)
+	err = store.Save(key, &gophercloud.StoredToken{
+		ID:               "stale",
+		ExpiresAt:        time.Now().Add(-time.Minute),
+		IdentityVersion:  v20,
+		IdentityEndpoint: th.Endpoint() + "v2.0/",
+		Catalog:          catalog,
+	})
+	th.AssertNoErr(t, err)
+
+	client, err := NewClient(options.IdentityEndpoint)
+	th.AssertNoErr(t, err)
+	th.AssertNoErr(t, AuthenticateV2(client, options))
+	th.CheckEquals(t, "fresh", client.Token())
+	th.CheckEquals(t, 1, issued)
+
+	stored, err := store.Load(key)
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "fresh", stored.ID)
+
+	// The token that replaced it is reused without asking the identity service.
+	client, err = NewClient(options.IdentityEndpoint)
+	th.AssertNoErr(t, err)
+	th.AssertNoErr(t, AuthenticateV2(client, options))
+	th.CheckEquals(t, "fresh", client.Token())
+	th.CheckEquals(t, 1, issued)
+}
@@ -0,0 +1,197 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/rackspace/gophercloud"
+)
+
+// Link is a link published in a version document.
+type Link struct {
+	Href string `json:"href"`
+	Rel  string `json:"rel"`
+}
+
+// APIVersion is one version of a service's API, as published in the service's version document.
+type APIVersion struct {
+	// ID identifies the version, e.g. "v2.1".
+	ID string `json:"id"`
+
+	// Status is the version's status, such as CURRENT, SUPPORTED or DEPRECATED.
+	Status string `json:"status"`
+
+	// Updated is the time at which the version last changed, as published by the service.
+	Updated string `json:"updated"`
+
+	// Links includes a "self" link to the version's base URL.
+	Links []Link `json:"links"`
+
+	// Version and MinVersion are the newest and oldest microversions that the version supports, if
+	// it supports microversions at all.
+	Version    string `json:"version"`
+	MinVersion string `json:"min_version"`
+}
+
+// SelfURL returns the base URL of the version, taken from its "self" link, with a trailing slash. It
+// returns "" if the version has no such link.
+func (v APIVersion) SelfURL() string {
+	for _, link := range v.Links {
+		if link.Rel == "self" && link.Href != "" {
+			if !strings.HasSuffix(link.Href, "/") {
+				return link.Href + "/"
+			}
+			return link.Href
+		}
+	}
+	return ""
+}
+
+// IsSupported reports whether the version's status allows it to be used, i.e. whether it's
+// current, supported or stable rather than deprecated or experimental.
+func (v APIVersion) IsSupported() bool {
+	return goodStatus[strings.ToLower(v.Status)]
+}
+
+// GetVersions fetches the version document at endpoint through client, bound to the client's
+// Context, and returns the versions that it lists. endpoint may be the root of a service, which
+// lists all of its versions, or the base URL of one version, which describes only that version. Both
+// the plain list that most services publish and the "values" object that the identity service
+// publishes are understood.
+func GetVersions(client *gophercloud.ServiceClient, endpoint string) ([]APIVersion, error) {
+	var resp struct {
+		Versions json.RawMessage `json:"versions"`
+		Version  *APIVersion     `json:"version"`
+	}
+
+	_, err := client.Request("GET", endpoint, gophercloud.RequestOpts{
+		JSONResponse: &resp,
+		OkCodes:      []int{200, 300},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.Version != nil {
+		return []APIVersion{*resp.Version}, nil
+	}
+	if len(resp.Versions) == 0 {
+		return nil, fmt.Errorf("No versions listed in the version document at %s", endpoint)
+	}
+
+	var versions []APIVersion
+	if err := json.Unmarshal(resp.Versions, &versions); err == nil {
+		return versions, nil
+	}
+
+	var values struct {
+		Values []APIVersion `json:"values"`
+	}
+	if err := json.Unmarshal(resp.Versions, &values); err != nil {
+		return nil, fmt.Errorf("Unable to parse the version document at %s: %v", endpoint, err)
+	}
+	return values.Values, nil
+}
+
+// ChooseAPIVersion picks the best version of a major API version, such as "v2", from versions. The
+// IDs of matching versions are either the major version itself or start with it, e.g. "v2.0" and
+// "v2.1". Among those that are supported, the CURRENT version wins, and then the newest one.
+func ChooseAPIVersion(versions []APIVersion, major string) (*APIVersion, error) {
+	var chosen *APIVersion
+	var chosenID Microversion
+
+	for i := range versions {
+		v := &versions[i]
+		if v.ID != major && !strings.HasPrefix(v.ID, major+".") {
+			continue
+		}
+		if !v.IsSupported() {
+			continue
+		}
+
+		id, err := parseVersionID(v.ID)
+		if err != nil {
+			continue
+		}
+
+		if chosen == nil {
+			chosen, chosenID = v, id
+			continue
+		}
+		current, chosenCurrent := strings.EqualFold(v.Status, "current"), strings.EqualFold(chosen.Status, "current")
+		if (current && !chosenCurrent) || (current == chosenCurrent && chosenID.Less(id)) {
+			chosen, chosenID = v, id
+		}
+	}
+
+	if chosen == nil {
+		return nil, fmt.Errorf("No supported %s version available", major)
+	}
+	return chosen, nil
+}
+
+// versionPattern matches a path segment that names an API version, e.g. "/v2.1/" or "/v2".
+var versionPattern = regexp.MustCompile(`/v\d+(\.\d+)?(/|$)`)
+
+// DiscoverEndpoint returns a copy of client whose ResourceBase is the base URL of the given major
+// version, e.g. "v2", of the service. It's meant for catalog entries that point at the root of a
+// service rather than at one of its versions:
+//
+//   compute, err := openstack.NewComputeV2(provider, gophercloud.EndpointOpts{Region: "RegionOne"})
+//   compute, err = utils.DiscoverEndpoint(compute.WithContext(ctx), "v2")
+//
+// The version document is fetched from the client's Endpoint, bound to its Context. Only the path
+// of the chosen version's "self" link is used, from its version segment onward, and it's joined onto
+// the Endpoint; the host that the service advertises is ignored, since it's often unreachable from
+// behind a proxy. Endpoints that already name a version are used as they are, without a request.
+func DiscoverEndpoint(client *gophercloud.ServiceClient, major string) (*gophercloud.ServiceClient, error) {
+	endpoint := client.Endpoint
+	if !strings.HasSuffix(endpoint, "/") {
+		endpoint += "/"
+	}
+
+	base, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	c := *client
+	if versionPattern.MatchString(base.Path) {
+		c.ResourceBase = endpoint
+		return &c, nil
+	}
+
+	versions, err := GetVersions(client, endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := ChooseAPIVersion(versions, major)
+	if err != nil {
+		return nil, fmt.Errorf("%v from endpoint %s", err, endpoint)
+	}
+
+	self, err := url.Parse(version.SelfURL())
+	if err != nil {
+		return nil, fmt.Errorf("Unable to parse the link to version %s from %s: %v", version.ID, endpoint, err)
+	}
+	loc := versionPattern.FindStringIndex(self.Path)
+	if loc == nil {
+		return nil, fmt.Errorf("Endpoint missing in version %s response from %s", version.ID, endpoint)
+	}
+
+	c.ResourceBase = endpoint + self.Path[loc[0]+1:]
+	return &c, nil
+}
+
+// parseVersionID parses a version ID such as "v2" or "v2.1".
+func parseVersionID(id string) (Microversion, error) {
+	if major, err := strconv.Atoi(strings.TrimPrefix(id, "v")); err == nil {
+		return Microversion{Major: major}, nil
+	}
+	return ParseMicroversion(id)
+}
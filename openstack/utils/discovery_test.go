@@ -0,0 +1,182 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/rackspace/gophercloud"
+	"github.com/rackspace/gophercloud/testhelper"
+)
+
+func setupComputeVersionsHandler() {
+	testhelper.Mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusMultipleChoices)
+		fmt.Fprintf(w, `
+			{
+				"versions": [
+					{
+						"id": "v2.0",
+						"status": "SUPPORTED",
+						"version": "",
+						"min_version": "",
+						"updated": "2011-01-21T11:33:21Z",
+						"links": [
+							{ "href": "%s/v2/", "rel": "self" }
+						]
+					},
+					{
+						"id": "v2.1",
+						"status": "CURRENT",
+						"version": "2.53",
+						"min_version": "2.1",
+						"updated": "2013-07-23T11:33:21Z",
+						"links": [
+							{ "href": "%s/v2.1/", "rel": "self" }
+						]
+					}
+				]
+			}
+		`, testhelper.Server.URL, testhelper.Server.URL)
+	})
+}
+
+func TestGetVersionsList(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+	setupComputeVersionsHandler()
+
+	versions, err := GetVersions(serviceClient(), testhelper.Endpoint())
+	testhelper.AssertNoErr(t, err)
+	testhelper.AssertEquals(t, 2, len(versions))
+
+	testhelper.CheckEquals(t, "v2.1", versions[1].ID)
+	testhelper.CheckEquals(t, "CURRENT", versions[1].Status)
+	testhelper.CheckEquals(t, "2.53", versions[1].Version)
+	testhelper.CheckEquals(t, "2.1", versions[1].MinVersion)
+	testhelper.CheckEquals(t, testhelper.Endpoint()+"v2.1/", versions[1].SelfURL())
+}
+
+func TestGetVersionsValues(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+	setupVersionHandler()
+
+	versions, err := GetVersions(serviceClient(), testhelper.Endpoint())
+	testhelper.AssertNoErr(t, err)
+	testhelper.AssertEquals(t, 2, len(versions))
+	testhelper.CheckEquals(t, "v3.0", versions[0].ID)
+	testhelper.CheckEquals(t, testhelper.Endpoint()+"v3.0/", versions[0].SelfURL())
+}
+
+func TestGetVersionsSingle(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+	setupMicroversionHandler("CURRENT")
+
+	versions, err := GetVersions(serviceClient(), testhelper.Endpoint()+"v2.1/")
+	testhelper.AssertNoErr(t, err)
+	testhelper.AssertEquals(t, 1, len(versions))
+	testhelper.CheckEquals(t, "v2.1", versions[0].ID)
+	testhelper.CheckEquals(t, "2.53", versions[0].Version)
+}
+
+func TestChooseAPIVersion(t *testing.T) {
+	versions := []APIVersion{
+		{ID: "v1.0", Status: "DEPRECATED"},
+		{ID: "v2.0", Status: "SUPPORTED"},
+		{ID: "v2.1", Status: "SUPPORTED"},
+		{ID: "v2.2", Status: "EXPERIMENTAL"},
+		{ID: "v20.0", Status: "CURRENT"},
+	}
+
+	v, err := ChooseAPIVersion(versions, "v2")
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckEquals(t, "v2.1", v.ID)
+
+	versions[1].Status = "CURRENT"
+	v, err = ChooseAPIVersion(versions, "v2")
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckEquals(t, "v2.0", v.ID)
+
+	_, err = ChooseAPIVersion(versions, "v1")
+	testhelper.AssertErr(t, err)
+}
+
+func serviceClient() *gophercloud.ServiceClient {
+	return &gophercloud.ServiceClient{ProviderClient: &gophercloud.ProviderClient{}, Endpoint: testhelper.Endpoint()}
+}
+
+func TestDiscoverEndpoint(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+	setupComputeVersionsHandler()
+
+	client, err := DiscoverEndpoint(serviceClient(), "v2")
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckEquals(t, testhelper.Endpoint(), client.Endpoint)
+	testhelper.CheckEquals(t, testhelper.Endpoint()+"v2.1/", client.ResourceBase)
+
+	_, err = DiscoverEndpoint(serviceClient(), "v3")
+	testhelper.AssertErr(t, err)
+}
+
+func TestDiscoverEndpointKeepsCatalogHost(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+
+	testhelper.Mux.HandleFunc("/compute/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusMultipleChoices)
+		fmt.Fprintf(w, `
+			{
+				"versions": [
+					{
+						"id": "v2.1",
+						"status": "CURRENT",
+						"links": [
+							{ "href": "http://nova.internal:8774/v2.1/", "rel": "self" }
+						]
+					}
+				]
+			}
+		`)
+	})
+
+	sc := serviceClient()
+	sc.Endpoint = testhelper.Endpoint() + "compute"
+
+	client, err := DiscoverEndpoint(sc, "v2")
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckEquals(t, testhelper.Endpoint()+"compute/v2.1/", client.ResourceBase)
+}
+
+func TestDiscoverEndpointVersioned(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+
+	testhelper.Mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Unexpected request to %s", r.URL)
+	})
+
+	sc := serviceClient()
+	sc.Endpoint = testhelper.Endpoint() + "v2/tenant/"
+
+	client, err := DiscoverEndpoint(sc, "v2")
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckEquals(t, testhelper.Endpoint()+"v2/tenant/", client.ResourceBase)
+}
+
+func TestDiscoverEndpointContext(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+	setupComputeVersionsHandler()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := DiscoverEndpoint(serviceClient().WithContext(ctx), "v2")
+	testhelper.AssertErr(t, err)
+}
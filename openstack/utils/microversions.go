@@ -0,0 +1,118 @@
+package utils
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/rackspace/gophercloud"
+)
+
+// Microversion is a microversion of a service's API, such as 2.53.
+type Microversion struct {
+	Major int
+	Minor int
+}
+
+// ParseMicroversion parses a microversion in the "major.minor" form that services publish, e.g.
+// "2.53". A leading "v" is ignored.
+func ParseMicroversion(s string) (Microversion, error) {
+	parts := strings.Split(strings.TrimPrefix(s, "v"), ".")
+	if len(parts) != 2 {
+		return Microversion{}, fmt.Errorf("Invalid microversion %q", s)
+	}
+	major, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return Microversion{}, fmt.Errorf("Invalid microversion %q", s)
+	}
+	minor, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return Microversion{}, fmt.Errorf("Invalid microversion %q", s)
+	}
+	return Microversion{Major: major, Minor: minor}, nil
+}
+
+// String formats the microversion as it's sent to the service.
+func (v Microversion) String() string {
+	return fmt.Sprintf("%d.%d", v.Major, v.Minor)
+}
+
+// Less reports whether v precedes other.
+func (v Microversion) Less(other Microversion) bool {
+	return v.Major < other.Major || (v.Major == other.Major && v.Minor < other.Minor)
+}
+
+// SupportedMicroversions is the range of microversions that a service's API supports.
+type SupportedMicroversions struct {
+	Min Microversion
+	Max Microversion
+}
+
+// IsSupported reports whether the service supports the given microversion.
+func (s SupportedMicroversions) IsSupported(microversion string) (bool, error) {
+	v, err := ParseMicroversion(microversion)
+	if err != nil {
+		return false, err
+	}
+	return !v.Less(s.Min) && !s.Max.Less(v), nil
+}
+
+// GetSupportedMicroversions reads the range of microversions that a service supports from the
+// version document of the version that the client addresses, e.g. v2.1 for a Compute client whose
+// base URL is https://compute.example.com/v2.1/. Anything after the version in the base URL, such as
+// Block Storage's project ID, is ignored. It returns an error if the version doesn't support
+// microversions, or isn't a current or supported version of the API.
+func GetSupportedMicroversions(client *gophercloud.ServiceClient) (SupportedMicroversions, error) {
+	base, err := url.Parse(client.ResourceBaseURL())
+	if err != nil {
+		return SupportedMicroversions{}, err
+	}
+	loc := versionPattern.FindStringIndex(base.Path)
+	if loc == nil {
+		return SupportedMicroversions{}, fmt.Errorf("No API version in the endpoint %s", client.ResourceBaseURL())
+	}
+	major := strings.Trim(base.Path[loc[0]:loc[1]], "/")
+	base.Path = base.Path[:loc[0]] + "/" + major + "/"
+	base.RawQuery = ""
+	endpoint := base.String()
+
+	versions, err := GetVersions(client, endpoint)
+	if err != nil {
+		return SupportedMicroversions{}, err
+	}
+	version, err := ChooseAPIVersion(versions, major)
+	if err != nil {
+		return SupportedMicroversions{}, fmt.Errorf("%v from endpoint %s", err, endpoint)
+	}
+	if version.Version == "" || version.MinVersion == "" {
+		return SupportedMicroversions{}, fmt.Errorf("Version %s at %s doesn't support microversions", version.ID, endpoint)
+	}
+
+	var supported SupportedMicroversions
+	if supported.Min, err = ParseMicroversion(version.MinVersion); err != nil {
+		return SupportedMicroversions{}, err
+	}
+	if supported.Max, err = ParseMicroversion(version.Version); err != nil {
+		return SupportedMicroversions{}, err
+	}
+	return supported, nil
+}
+
+// RequireMicroversion returns a copy of client that requests the given microversion, or an error if
+// the service doesn't support it.
+func RequireMicroversion(client *gophercloud.ServiceClient, microversion string) (*gophercloud.ServiceClient, error) {
+	supported, err := GetSupportedMicroversions(client)
+	if err != nil {
+		return nil, err
+	}
+	ok, err := supported.IsSupported(microversion)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, fmt.Errorf("Microversion %s isn't supported by %s, which supports %s to %s",
+			microversion, client.Endpoint, supported.Min, supported.Max)
+	}
+	return client.WithMicroversion(microversion), nil
+}
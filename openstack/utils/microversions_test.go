@@ -0,0 +1,118 @@
+package utils
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/rackspace/gophercloud"
+	"github.com/rackspace/gophercloud/testhelper"
+)
+
+func setupMicroversionHandler(status string) {
+	testhelper.Mux.HandleFunc("/v2.1/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `
+			{
+				"version": {
+					"id": "v2.1",
+					"status": "%s",
+					"version": "2.53",
+					"min_version": "2.1",
+					"links": [
+						{ "href": "%s/v2.1/", "rel": "self" }
+					]
+				}
+			}
+		`, status, testhelper.Server.URL)
+	})
+}
+
+func microversionClient() *gophercloud.ServiceClient {
+	return &gophercloud.ServiceClient{
+		ProviderClient: &gophercloud.ProviderClient{},
+		Endpoint:       testhelper.Endpoint() + "v2.1/",
+		Type:           "compute",
+	}
+}
+
+func TestGetSupportedMicroversions(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+	setupMicroversionHandler("CURRENT")
+
+	supported, err := GetSupportedMicroversions(microversionClient())
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckEquals(t, Microversion{Major: 2, Minor: 1}, supported.Min)
+	testhelper.CheckEquals(t, Microversion{Major: 2, Minor: 53}, supported.Max)
+
+	for version, expected := range map[string]bool{"2.0": false, "2.1": true, "2.26": true, "2.53": true, "2.60": false, "3.1": false} {
+		ok, err := supported.IsSupported(version)
+		testhelper.AssertNoErr(t, err)
+		if ok != expected {
+			t.Errorf("Expected IsSupported(%q) to be %t", version, expected)
+		}
+	}
+
+	_, err = supported.IsSupported("latest")
+	testhelper.AssertErr(t, err)
+}
+
+func TestGetSupportedMicroversionsDeprecated(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+	setupMicroversionHandler("DEPRECATED")
+
+	_, err := GetSupportedMicroversions(microversionClient())
+	testhelper.AssertErr(t, err)
+}
+
+func TestRequireMicroversion(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+	setupMicroversionHandler("CURRENT")
+
+	client, err := RequireMicroversion(microversionClient(), "2.26")
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckEquals(t, "2.26", client.Microversion)
+
+	_, err = RequireMicroversion(microversionClient(), "2.60")
+	testhelper.AssertErr(t, err)
+}
+
+func TestGetSupportedMicroversionsBlockStorage(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+
+	testhelper.Mux.HandleFunc("/v3/", func(w http.ResponseWriter, r *http.Request) {
+		testhelper.CheckEquals(t, "/v3/", r.URL.Path)
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `
+			{
+				"versions": [
+					{
+						"id": "v3.0",
+						"status": "CURRENT",
+						"version": "3.59",
+						"min_version": "3.0",
+						"updated": "2016-02-08T12:20:21Z",
+						"links": [
+							{ "href": "%s/v3/", "rel": "self" }
+						]
+					}
+				]
+			}
+		`, testhelper.Server.URL)
+	})
+
+	client := &gophercloud.ServiceClient{
+		ProviderClient: &gophercloud.ProviderClient{},
+		Endpoint:       testhelper.Endpoint() + "v3/0e1f8a5d4c2b4e6f/",
+		Type:           "volumev3",
+	}
+
+	supported, err := GetSupportedMicroversions(client)
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckEquals(t, Microversion{Major: 3, Minor: 0}, supported.Min)
+	testhelper.CheckEquals(t, Microversion{Major: 3, Minor: 59}, supported.Max)
+}
@@ -0,0 +1,235 @@
+package pagination
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// pageStream yields the non-empty pages of a collection in order. next returns a nil Page once
+// the collection is exhausted. close releases any resources held by the stream, and must be
+// called if the stream isn't read to the end.
+type pageStream interface {
+	next() (Page, error)
+	close()
+}
+
+// pages returns a stream over p's pages that prefetches them if p.Prefetch is set.
+func (p Pager) pages() pageStream {
+	w := &pageWalker{pager: p, url: p.initialURL}
+	if p.Prefetch > 0 {
+		return newPrefetcher(w, p.Prefetch)
+	}
+	return w
+}
+
+// pageWalker fetches each page when it's asked for.
+type pageWalker struct {
+	pager   Pager
+	url     string
+	current Page
+	done    bool
+}
+
+func (w *pageWalker) next() (Page, error) {
+	if w.done {
+		return nil, nil
+	}
+
+	page, err := w.advance()
+	if page == nil || err != nil {
+		w.done = true
+		return nil, err
+	}
+	w.current = page
+	return page, nil
+}
+
+func (w *pageWalker) advance() (Page, error) {
+	if w.current != nil {
+		url, err := w.current.NextPageURL()
+		if err != nil || url == "" {
+			return nil, err
+		}
+		w.url = url
+	}
+
+	// Stop between pages if the client's context has been cancelled.
+	if ctx := w.pager.client.Context; ctx != nil && ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
+	page, err := w.pager.fetchNextPage(w.url)
+	if err != nil {
+		return nil, err
+	}
+
+	empty, err := page.IsEmpty()
+	if err != nil || empty {
+		return nil, err
+	}
+	return page, nil
+}
+
+func (w *pageWalker) close() {}
+
+type pageOrError struct {
+	page Page
+	err  error
+}
+
+// prefetcher walks the pages of a collection in the background, staying at most n pages ahead of
+// its reader.
+type prefetcher struct {
+	results chan pageOrError
+	stop    chan struct{}
+	once    sync.Once
+}
+
+func newPrefetcher(w *pageWalker, n int) *prefetcher {
+	// The walker holds one page while it waits to deliver it, so buffer one page fewer.
+	f := &prefetcher{
+		results: make(chan pageOrError, n-1),
+		stop:    make(chan struct{}),
+	}
+
+	go func() {
+		defer close(f.results)
+		for {
+			page, err := w.next()
+			select {
+			case f.results <- pageOrError{page, err}:
+			case <-f.stop:
+				return
+			}
+			if page == nil {
+				return
+			}
+		}
+	}()
+
+	return f
+}
+
+func (f *prefetcher) next() (Page, error) {
+	r, ok := <-f.results
+	if !ok {
+		return nil, nil
+	}
+	return r.page, r.err
+}
+
+func (f *prefetcher) close() {
+	f.once.Do(func() { close(f.stop) })
+}
+
+/*
+Iterator yields the individual items of a paginated collection, requesting pages as it goes. Only
+the current page, and any pages requested ahead of it as set by Pager.Prefetch, are held in memory,
+so it's suitable for collections too large to load with AllPages.
+
+  pager := servers.List(client, nil)
+  pager.Prefetch = 1
+
+  it := pager.Iter(servers.ExtractServers)
+  defer it.Close()
+  for it.Next() {
+    server := it.Item().(servers.Server)
+    ...
+  }
+  if err := it.Err(); err != nil {
+    ...
+  }
+*/
+type Iterator struct {
+	pages   pageStream
+	extract reflect.Value
+	items   reflect.Value
+	index   int
+	item    interface{}
+	err     error
+}
+
+var (
+	pageType  = reflect.TypeOf((*Page)(nil)).Elem()
+	errorType = reflect.TypeOf((*error)(nil)).Elem()
+)
+
+// Iter returns an Iterator over the items of the collection. extract must be a function that
+// converts a Page into a slice of items, such as the Extract function of the resource package that
+// created the Pager, e.g. servers.ExtractServers. If it isn't, the Iterator yields no items and Err
+// describes the problem.
+func (p Pager) Iter(extract interface{}) *Iterator {
+	if p.Err != nil {
+		return &Iterator{err: p.Err}
+	}
+
+	fn := reflect.ValueOf(extract)
+	if !fn.IsValid() {
+		return &Iterator{err: fmt.Errorf("Expected a func(pagination.Page) ([]T, error), got nil")}
+	}
+	t := fn.Type()
+	if t.Kind() != reflect.Func || t.NumIn() != 1 || !pageType.AssignableTo(t.In(0)) ||
+		t.NumOut() != 2 || t.Out(0).Kind() != reflect.Slice || t.Out(1) != errorType {
+		return &Iterator{err: fmt.Errorf("Expected a func(pagination.Page) ([]T, error), got %T", extract)}
+	}
+
+	return &Iterator{pages: p.pages(), extract: fn}
+}
+
+// Next advances the Iterator to the next item, requesting the next page if necessary. It returns
+// false once the collection is exhausted or an error occurs; call Err to distinguish the two.
+func (it *Iterator) Next() bool {
+	if it.err != nil || it.pages == nil {
+		return false
+	}
+
+	for !it.items.IsValid() || it.index >= it.items.Len() {
+		page, err := it.pages.next()
+		if err != nil {
+			it.fail(err)
+			return false
+		}
+		if page == nil {
+			it.Close()
+			return false
+		}
+
+		out := it.extract.Call([]reflect.Value{reflect.ValueOf(page)})
+		if err, _ := out[1].Interface().(error); err != nil {
+			it.fail(err)
+			return false
+		}
+		it.items, it.index = out[0], 0
+	}
+
+	it.item = it.items.Index(it.index).Interface()
+	it.index++
+	return true
+}
+
+// Item returns the item that the last successful call to Next advanced to. Assert it to the element
+// type of the slice returned by the extract function that was passed to Iter.
+func (it *Iterator) Item() interface{} {
+	return it.item
+}
+
+// Err returns the error that stopped the Iterator, if any.
+func (it *Iterator) Err() error {
+	return it.err
+}
+
+// Close stops any prefetching of pages. It's only needed if iteration ends before Next returns
+// false, but it's always safe to call.
+func (it *Iterator) Close() {
+	if it.pages != nil {
+		it.pages.close()
+		it.pages = nil
+	}
+	it.items = reflect.Value{}
+}
+
+func (it *Iterator) fail(err error) {
+	it.err = err
+	it.Close()
+}
@@ -0,0 +1,143 @@
+package pagination
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rackspace/gophercloud/testhelper"
+)
+
+func collectItems(t *testing.T, it *Iterator) []interface{} {
+	defer it.Close()
+
+	var items []interface{}
+	for it.Next() {
+		items = append(items, it.Item())
+	}
+	testhelper.AssertNoErr(t, it.Err())
+	return items
+}
+
+func TestIterLinked(t *testing.T) {
+	pager := createLinked(t)
+	defer testhelper.TeardownHTTP()
+
+	items := collectItems(t, pager.Iter(ExtractLinkedInts))
+	testhelper.CheckDeepEquals(t, []interface{}{1, 2, 3, 4, 5, 6, 7, 8, 9}, items)
+}
+
+func TestIterMarkerPrefetch(t *testing.T) {
+	pager := createMarkerPaged(t)
+	defer testhelper.TeardownHTTP()
+
+	pager.Prefetch = 2
+	items := collectItems(t, pager.Iter(ExtractMarkerStrings))
+	testhelper.CheckDeepEquals(t, []interface{}{"aaa", "bbb", "ccc", "ddd", "eee", "fff", "ggg", "hhh", "iii"}, items)
+}
+
+func TestIterSingle(t *testing.T) {
+	pager := setupSinglePaged()
+	defer testhelper.TeardownHTTP()
+
+	pager.Prefetch = 1
+	items := collectItems(t, pager.Iter(ExtractSingleInts))
+	testhelper.CheckDeepEquals(t, []interface{}{1, 2, 3}, items)
+}
+
+func TestIterRejectsBadExtractFunc(t *testing.T) {
+	pager := createLinked(t)
+	defer testhelper.TeardownHTTP()
+
+	it := pager.Iter(func(page Page) []int { return nil })
+	testhelper.CheckEquals(t, false, it.Next())
+	testhelper.AssertErr(t, it.Err())
+}
+
+func TestIterStopsOnError(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+
+	testhelper.Mux.HandleFunc("/page1", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{ "ints": [1, 2], "links": { "next": "%s/page2" } }`, testhelper.Server.URL)
+	})
+	testhelper.Mux.HandleFunc("/page2", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	pager := NewPager(createClient(), testhelper.Server.URL+"/page1", func(r PageResult) Page {
+		return LinkedPageResult{LinkedPageBase{PageResult: r}}
+	})
+	pager.Prefetch = 1
+
+	it := pager.Iter(ExtractLinkedInts)
+	var items []interface{}
+	for it.Next() {
+		items = append(items, it.Item())
+	}
+	testhelper.CheckDeepEquals(t, []interface{}{1, 2}, items)
+	testhelper.AssertErr(t, it.Err())
+}
+
+// TestPrefetchOverlapsHandler checks that the next page is requested while the handler is still
+// busy with the current one, but that no more than Prefetch pages are requested ahead of it.
+func TestPrefetchOverlapsHandler(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+
+	var mu sync.Mutex
+	requested := 0
+	for i := 1; i <= 5; i++ {
+		i := i
+		testhelper.Mux.HandleFunc(fmt.Sprintf("/page%d", i), func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			requested++
+			mu.Unlock()
+
+			next := "null"
+			if i < 5 {
+				next = fmt.Sprintf(`"%s/page%d"`, testhelper.Server.URL, i+1)
+			}
+			w.Header().Add("Content-Type", "application/json")
+			fmt.Fprintf(w, `{ "ints": [%d], "links": { "next": %s } }`, i, next)
+		})
+	}
+
+	pager := NewPager(createClient(), testhelper.Server.URL+"/page1", func(r PageResult) Page {
+		return LinkedPageResult{LinkedPageBase{PageResult: r}}
+	})
+	pager.Prefetch = 2
+
+	handled := 0
+	err := pager.EachPage(func(page Page) (bool, error) {
+		handled++
+		if handled == 1 {
+			// Give the prefetcher time to run as far ahead as it's allowed to.
+			time.Sleep(100 * time.Millisecond)
+
+			mu.Lock()
+			testhelper.CheckEquals(t, 3, requested)
+			mu.Unlock()
+		}
+		return true, nil
+	})
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckEquals(t, 5, handled)
+	testhelper.CheckEquals(t, 5, requested)
+}
+
+func TestPrefetchStopsEarly(t *testing.T) {
+	pager := createLinked(t)
+	defer testhelper.TeardownHTTP()
+
+	pager.Prefetch = 1
+	it := pager.Iter(ExtractLinkedInts)
+	testhelper.CheckEquals(t, true, it.Next())
+	testhelper.CheckEquals(t, 1, it.Item())
+	it.Close()
+	testhelper.CheckEquals(t, false, it.Next())
+	testhelper.AssertNoErr(t, it.Err())
+}
@@ -1,6 +1,7 @@
 package pagination
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"reflect"
@@ -118,3 +119,63 @@ func TestAllPagesLinked(t *testing.T) {
 	testhelper.AssertNoErr(t, err)
 	testhelper.CheckDeepEquals(t, expected, actual)
 }
+
+func TestEnumerateLinkedCancelled(t *testing.T) {
+	pager := createLinked(t)
+	defer testhelper.TeardownHTTP()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	pager.client = pager.client.WithContext(ctx)
+
+	callCount := 0
+	err := pager.EachPage(func(page Page) (bool, error) {
+		callCount++
+		cancel()
+		return true, nil
+	})
+	testhelper.CheckEquals(t, context.Canceled, err)
+	testhelper.CheckEquals(t, 1, callCount)
+}
+
+// KeyedPageResult is a linked page whose body holds a schema alongside its items.
+type KeyedPageResult struct {
+	LinkedPageBase
+}
+
+func (r KeyedPageResult) IsEmpty() (bool, error) {
+	return false, nil
+}
+
+func (r KeyedPageResult) CollectionKey() string {
+	return "ints"
+}
+
+func TestAllPagesCollectionKey(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+
+	testhelper.Mux.HandleFunc("/page1", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{ "ints": [1, 2], "schema": "/schemas/ints", "links": { "next": "%s/page2" } }`, testhelper.Server.URL)
+	})
+
+	testhelper.Mux.HandleFunc("/page2", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{ "ints": [3], "schema": "/schemas/ints", "links": { "next": null } }`)
+	})
+
+	createPage := func(r PageResult) Page {
+		return KeyedPageResult{LinkedPageBase{PageResult: r}}
+	}
+	pager := NewPager(createClient(), testhelper.Server.URL+"/page1", createPage)
+
+	page, err := pager.AllPages()
+	testhelper.AssertNoErr(t, err)
+
+	var response struct {
+		Ints []int `mapstructure:"ints"`
+	}
+	err = mapstructure.Decode(page.(KeyedPageResult).Body, &response)
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckDeepEquals(t, []int{1, 2, 3}, response.Ints)
+}
@@ -34,6 +34,20 @@ type Page interface {
 	GetBody() interface{}
 }
 
+// CollectionPage is implemented by pages whose body is a JSON object that holds more than just the
+// collection's items and its links, such as a schema or a next marker. CollectionKey names the key
+// that holds the items, so that `AllPages` knows which one to concatenate. Without it, `AllPages`
+// uses whichever key doesn't end in "links", which is only reliable if there's just one.
+//
+// The ListAll functions of the resource packages are built on `AllPages`, and so rely on their
+// page types implementing CollectionPage whenever the page body holds more than the items.
+type CollectionPage interface {
+	Page
+
+	// CollectionKey returns the key of the page body that holds the collection's items.
+	CollectionKey() string
+}
+
 // Pager knows how to advance through a specific resource collection, one page at a time.
 type Pager struct {
 	client *gophercloud.ServiceClient
@@ -46,6 +60,11 @@ type Pager struct {
 
 	// Headers supplies additional HTTP headers to populate on each paged request.
 	Headers map[string]string
+
+	// Prefetch is the number of pages that EachPage and Iter may request ahead of the page being
+	// handled, so that a slow handler overlaps with the requests for the pages that follow. The
+	// default, zero, requests each page only once the previous one has been handled.
+	Prefetch int
 }
 
 // NewPager constructs a manually-configured pager.
@@ -65,6 +84,7 @@ func (p Pager) WithPageCreator(createPage func(r PageResult) Page) Pager {
 		client:     p.client,
 		initialURL: p.initialURL,
 		createPage: createPage,
+		Prefetch:   p.Prefetch,
 	}
 }
 
@@ -83,23 +103,22 @@ func (p Pager) fetchNextPage(url string) (Page, error) {
 }
 
 // EachPage iterates over each page returned by a Pager, yielding one at a time to a handler function.
-// Return "false" from the handler to prematurely stop iterating.
+// Return "false" from the handler to prematurely stop iterating. If the Pager's ServiceClient carries a
+// Context, iteration is aborted with the context's error once it is done.
 func (p Pager) EachPage(handler func(Page) (bool, error)) error {
 	if p.Err != nil {
 		return p.Err
 	}
-	currentURL := p.initialURL
-	for {
-		currentPage, err := p.fetchNextPage(currentURL)
-		if err != nil {
-			return err
-		}
 
-		empty, err := currentPage.IsEmpty()
+	pages := p.pages()
+	defer pages.close()
+
+	for {
+		currentPage, err := pages.next()
 		if err != nil {
 			return err
 		}
-		if empty {
+		if currentPage == nil {
 			return nil
 		}
 
@@ -110,14 +129,6 @@ func (p Pager) EachPage(handler func(Page) (bool, error)) error {
 		if !ok {
 			return nil
 		}
-
-		currentURL, err = currentPage.NextPageURL()
-		if err != nil {
-			return err
-		}
-		if currentURL == "" {
-			return nil
-		}
 	}
 }
 
@@ -149,20 +160,27 @@ func (p Pager) AllPages() (Page, error) {
 	case map[string]interface{}:
 		// key is the map key for the page body if the body type is `map[string]interface{}`.
 		var key string
+		if cp, ok := testPage.(CollectionPage); ok {
+			key = cp.CollectionKey()
+		}
 		// Iterate over the pages to concatenate the bodies.
 		err := p.EachPage(func(page Page) (bool, error) {
 			b := page.GetBody().(map[string]interface{})
-			for k := range b {
-				// If it's a linked page, we don't want the `links`, we want the other one.
-				if !strings.HasSuffix(k, "links") {
-					key = k
+			if _, ok := page.(CollectionPage); !ok {
+				for k := range b {
+					// If it's a linked page, we don't want the `links`, we want the other one.
+					if !strings.HasSuffix(k, "links") {
+						key = k
+					}
 				}
 			}
 			switch keyType := b[key].(type) {
+			case nil:
+				// The page doesn't hold any items.
 			case map[string]interface{}:
 				pagesSlice = append(pagesSlice, keyType)
 			case []interface{}:
-				pagesSlice = append(pagesSlice, b[key].([]interface{})...)
+				pagesSlice = append(pagesSlice, keyType...)
 			default:
 				return false, fmt.Errorf("Unsupported page body type: %+v", keyType)
 			}
@@ -172,8 +190,7 @@ func (p Pager) AllPages() (Page, error) {
 			return nil, err
 		}
 		// Set body to value of type `map[string]interface{}`
-		body = reflect.MakeMap(reflect.MapOf(reflect.TypeOf(key), reflect.TypeOf(pagesSlice)))
-		body.SetMapIndex(reflect.ValueOf(key), reflect.ValueOf(pagesSlice))
+		body = reflect.ValueOf(map[string]interface{}{key: pagesSlice})
 	case []byte:
 		// Iterate over the pages to concatenate the bodies.
 		err := p.EachPage(func(page Page) (bool, error) {
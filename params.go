@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/url"
 	"reflect"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -90,6 +91,30 @@ func isZero(v reflect.Value) bool {
 	return v.Interface() == z.Interface()
 }
 
+// FilterOperator compares a resource's attribute with the value of a QueryFilter.
+type FilterOperator string
+
+// Operators understood by services that support filtering with operators, such as the Image
+// service's v2 API.
+const (
+	FilterEqual              FilterOperator = "eq"
+	FilterNotEqual           FilterOperator = "neq"
+	FilterGreaterThan        FilterOperator = "gt"
+	FilterGreaterThanOrEqual FilterOperator = "gte"
+	FilterLessThan           FilterOperator = "lt"
+	FilterLessThanOrEqual    FilterOperator = "lte"
+	FilterIn                 FilterOperator = "in"
+)
+
+// QueryFilter is a query parameter whose value is prefixed by an operator, e.g.
+// "created_at=gte:2016-01-01T00:00:00Z" or "id=in:a,b". Value may be any type that BuildQueryString
+// supports; the elements of a slice are separated by commas, as the "in" operator expects. Use a
+// slice of QueryFilters to bound a value from both sides.
+type QueryFilter struct {
+	Operator FilterOperator
+	Value    interface{}
+}
+
 /*
 BuildQueryString is an internal function to be used by request methods in
 individual resource packages.
@@ -109,8 +134,11 @@ converted into query parameters based on a "q" tag. For example:
 
 will be converted into "?x_bar=AAA&lorem_ipsum=BBB".
 
-The struct's fields may be strings, integers, or boolean values. Fields left at
-their type's zero value will be omitted from the query.
+The struct's fields may be strings, integers, floats, boolean values, time.Time
+values (formatted as RFC 3339), QueryFilters, or pointers to any of these. Slices
+of them repeat the parameter once for each element, e.g. "?id=a&id=b". Maps with
+string keys are formatted as a single dictionary, e.g. "?metadata={'k':'v'}".
+Fields left at their type's zero value will be omitted from the query.
 */
 func BuildQueryString(opts interface{}) (*url.URL, error) {
 	optsValue := reflect.ValueOf(opts)
@@ -138,23 +166,26 @@ func BuildQueryString(opts interface{}) (*url.URL, error) {
 				// if the field is set, add it to the slice of query pieces
 				if !isZero(v) {
 					switch v.Kind() {
-					case reflect.String:
-						params.Add(tags[0], v.String())
-					case reflect.Int:
-						params.Add(tags[0], strconv.FormatInt(v.Int(), 10))
-					case reflect.Bool:
-						params.Add(tags[0], strconv.FormatBool(v.Bool()))
-					case reflect.Slice:
-						switch v.Type().Elem() {
-						case reflect.TypeOf(0):
-							for i := 0; i < v.Len(); i++ {
-								params.Add(tags[0], strconv.FormatInt(v.Index(i).Int(), 10))
-							}
-						default:
-							for i := 0; i < v.Len(); i++ {
-								params.Add(tags[0], v.Index(i).String())
+					case reflect.Slice, reflect.Array:
+						for i := 0; i < v.Len(); i++ {
+							s, err := queryValue(v.Index(i))
+							if err != nil {
+								return nil, fmt.Errorf("Query parameter [%s]: %v", f.Name, err)
 							}
+							params.Add(tags[0], s)
+						}
+					case reflect.Map:
+						s, err := queryMap(v)
+						if err != nil {
+							return nil, fmt.Errorf("Query parameter [%s]: %v", f.Name, err)
 						}
+						params.Add(tags[0], s)
+					default:
+						s, err := queryValue(v)
+						if err != nil {
+							return nil, fmt.Errorf("Query parameter [%s]: %v", f.Name, err)
+						}
+						params.Add(tags[0], s)
 					}
 				} else {
 					// Otherwise, the field is not set.
@@ -172,6 +203,89 @@ func BuildQueryString(opts interface{}) (*url.URL, error) {
 	return nil, fmt.Errorf("Options type is not a struct.")
 }
 
+// queryValue formats a single value of a query parameter.
+func queryValue(v reflect.Value) (string, error) {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return "", nil
+		}
+		return queryValue(v.Elem())
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 64), nil
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), nil
+	case reflect.Struct:
+		switch value := v.Interface().(type) {
+		case time.Time:
+			return value.Format(time.RFC3339), nil
+		case QueryFilter:
+			return queryFilter(value)
+		}
+	}
+	return "", fmt.Errorf("unsupported type %s", v.Type())
+}
+
+// queryFilter formats a QueryFilter as "operator:value", joining the elements of a slice value
+// with commas.
+func queryFilter(filter QueryFilter) (string, error) {
+	v := reflect.ValueOf(filter.Value)
+
+	var s string
+	if v.Kind() == reflect.Slice || v.Kind() == reflect.Array {
+		values := make([]string, v.Len())
+		for i := range values {
+			value, err := queryValue(v.Index(i))
+			if err != nil {
+				return "", err
+			}
+			values[i] = value
+		}
+		s = strings.Join(values, ",")
+	} else if v.IsValid() {
+		value, err := queryValue(v)
+		if err != nil {
+			return "", err
+		}
+		s = value
+	}
+
+	if filter.Operator == "" {
+		return s, nil
+	}
+	return string(filter.Operator) + ":" + s, nil
+}
+
+// queryMap formats a map with string keys as a dictionary literal, e.g. "{'k1':'v1','k2':'v2'}",
+// with its keys in order.
+func queryMap(v reflect.Value) (string, error) {
+	if v.Type().Key().Kind() != reflect.String {
+		return "", fmt.Errorf("unsupported type %s", v.Type())
+	}
+
+	keys := make([]string, 0, v.Len())
+	for _, k := range v.MapKeys() {
+		keys = append(keys, k.String())
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, len(keys))
+	for i, k := range keys {
+		value, err := queryValue(v.MapIndex(reflect.ValueOf(k).Convert(v.Type().Key())))
+		if err != nil {
+			return "", err
+		}
+		pairs[i] = fmt.Sprintf("'%s':'%s'", k, value)
+	}
+	return "{" + strings.Join(pairs, ",") + "}", nil
+}
+
 /*
 BuildHeaders is an internal function to be used by request methods in
 individual resource packages.
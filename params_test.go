@@ -80,6 +80,63 @@ func TestBuildQueryString(t *testing.T) {
 	}
 }
 
+func TestBuildQueryStringTypes(t *testing.T) {
+	enabled := true
+	created := time.Date(2016, 1, 2, 3, 4, 5, 0, time.UTC)
+	opts := struct {
+		Enabled  *bool             `q:"enabled"`
+		Size     int64             `q:"size"`
+		Ratio    float64           `q:"ratio"`
+		Since    time.Time         `q:"since"`
+		Metadata map[string]string `q:"metadata"`
+		ID       QueryFilter       `q:"id"`
+		Created  []QueryFilter     `q:"created_at"`
+		Fields   []string          `q:"fields"`
+	}{
+		Enabled:  &enabled,
+		Size:     1024,
+		Ratio:    0.5,
+		Since:    created,
+		Metadata: map[string]string{"b": "2", "a": "1"},
+		ID:       QueryFilter{Operator: FilterIn, Value: []string{"x", "y"}},
+		Created: []QueryFilter{
+			{Operator: FilterGreaterThanOrEqual, Value: created},
+			{Operator: FilterLessThan, Value: created.AddDate(0, 0, 1)},
+		},
+		Fields: []string{"id", "name"},
+	}
+
+	actual, err := BuildQueryString(opts)
+	th.AssertNoErr(t, err)
+
+	expected := url.Values{
+		"enabled":    {"true"},
+		"size":       {"1024"},
+		"ratio":      {"0.5"},
+		"since":      {"2016-01-02T03:04:05Z"},
+		"metadata":   {"{'a':'1','b':'2'}"},
+		"id":         {"in:x,y"},
+		"created_at": {"gte:2016-01-02T03:04:05Z", "lt:2016-01-03T03:04:05Z"},
+		"fields":     {"id", "name"},
+	}
+	th.CheckDeepEquals(t, expected, actual.Query())
+
+	// Zero values are omitted, including nil pointers and empty filters.
+	actual, err = BuildQueryString(struct {
+		Enabled *bool       `q:"enabled"`
+		ID      QueryFilter `q:"id"`
+	}{})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "", actual.RawQuery)
+
+	_, err = BuildQueryString(struct {
+		Channel chan int `q:"channel"`
+	}{Channel: make(chan int)})
+	if err == nil {
+		t.Errorf("Expected error: 'unsupported type'")
+	}
+}
+
 func TestBuildHeaders(t *testing.T) {
 	testStruct := struct {
 		Accept string `h:"Accept"`
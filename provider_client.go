@@ -2,17 +2,23 @@ package gophercloud
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
 	"strings"
+	"sync"
+	"time"
 )
 
 // DefaultUserAgent is the default User-Agent string set in the request header.
 const DefaultUserAgent = "gophercloud/1.0.0"
 
+// DefaultTokenRenewalMargin is used when ProviderClient.TokenRenewalMargin is unset.
+const DefaultTokenRenewalMargin = 5 * time.Minute
+
 // UserAgent represents a User-Agent header.
 type UserAgent struct {
 	// prepend is the slice of User-Agent strings to prepend to DefaultUserAgent.
@@ -51,13 +57,18 @@ type ProviderClient struct {
 	// than querying versions first.
 	IdentityEndpoint string
 
-	// TokenID is the ID of the most recently issued valid token.
+	// TokenID is the ID of the most recently issued valid token. Once the client
+	// is shared between goroutines, use Token and SetToken to access it instead.
 	TokenID string
 
 	// EndpointLocator describes how this provider discovers the endpoints for
 	// its constituent services.
 	EndpointLocator EndpointLocator
 
+	// ServiceCatalog lists the services and endpoints that the identity service
+	// returned when the client authenticated. It's nil until then.
+	ServiceCatalog *ServiceCatalog
+
 	// HTTPClient allows users to interject arbitrary http, https, or other transit behaviors.
 	HTTPClient http.Client
 
@@ -68,15 +79,192 @@ type ProviderClient struct {
 	// fails with a 401 HTTP response code. This a needed because there may be multiple
 	// authentication functions for different Identity service versions.
 	ReauthFunc func() error
+
+	// RetryPolicy, if set, controls how requests that fail for a transient reason
+	// (rate limiting, an unavailable API node, a dropped connection) are retried.
+	RetryPolicy *RetryPolicy
+
+	// DisableKeepAlives forces every request to use a new connection, which is
+	// closed as soon as the response has been read. By default, connections are
+	// kept alive and reused according to HTTPClient's Transport.
+	DisableKeepAlives bool
+
+	// Interceptors are notified of every HTTP request issued by this client, just
+	// before it's sent and again once its response has arrived. See DebugLogger for
+	// a ready-made Interceptor that logs the traffic.
+	Interceptors []Interceptor
+
+	// TokenRenewalMargin is how long before its expiry the token is renewed, if
+	// its expiry is known and ReauthFunc is set. Renewing ahead of time avoids
+	// 401 responses, which can't be recovered from for requests whose body can't
+	// be rewound. Renewal is best effort: if it fails, the current token keeps
+	// being used until a 401 triggers the usual reauthentication. Defaults to
+	// DefaultTokenRenewalMargin; set a negative value to only re-authenticate
+	// after a 401.
+	TokenRenewalMargin time.Duration
+
+	// mu guards TokenID and tokenExpiresAt.
+	mu             sync.RWMutex
+	tokenExpiresAt time.Time
+
+	// reauthMu guards reauth, the reauthentication currently in progress, if any.
+	reauthMu sync.Mutex
+	reauth   *reauthCall
+}
+
+// reauthCall tracks a single invocation of ReauthFunc, shared by every request
+// that was rejected with the same token.
+type reauthCall struct {
+	done chan struct{}
+	err  error
+}
+
+// Token safely reads the ID of the current token.
+func (client *ProviderClient) Token() string {
+	client.mu.RLock()
+	defer client.mu.RUnlock()
+	return client.TokenID
+}
+
+// SetToken safely replaces the current token. ReauthFunc implementations
+// should use it to store the token they obtain. The expiry of the new token
+// is unknown until SetTokenExpiresAt is called.
+func (client *ProviderClient) SetToken(t string) {
+	client.mu.Lock()
+	defer client.mu.Unlock()
+	client.TokenID = t
+	client.tokenExpiresAt = time.Time{}
+}
+
+// TokenExpiresAt returns the time at which the current token expires, or the
+// zero time if that's unknown.
+func (client *ProviderClient) TokenExpiresAt() time.Time {
+	client.mu.RLock()
+	defer client.mu.RUnlock()
+	return client.tokenExpiresAt
+}
+
+// SetTokenExpiresAt records the time at which the current token expires, so
+// that it can be renewed ahead of time.
+func (client *ProviderClient) SetTokenExpiresAt(t time.Time) {
+	client.mu.Lock()
+	defer client.mu.Unlock()
+	client.tokenExpiresAt = t
+}
+
+// CopyTokenFrom adopts the token of other, along with its expiry. ReauthFunc
+// implementations use it after authenticating the client returned by
+// ReauthClient.
+func (client *ProviderClient) CopyTokenFrom(other *ProviderClient) {
+	other.mu.RLock()
+	t, expiresAt := other.TokenID, other.tokenExpiresAt
+	other.mu.RUnlock()
+
+	client.mu.Lock()
+	defer client.mu.Unlock()
+	client.TokenID = t
+	client.tokenExpiresAt = expiresAt
 }
 
 // AuthenticatedHeaders returns a map of HTTP headers that are common for all
 // authenticated service requests.
 func (client *ProviderClient) AuthenticatedHeaders() map[string]string {
-	if client.TokenID == "" {
+	t := client.Token()
+	if t == "" {
 		return map[string]string{}
 	}
-	return map[string]string{"X-Auth-Token": client.TokenID}
+	return map[string]string{"X-Auth-Token": t}
+}
+
+// ReauthClient returns an unauthenticated ProviderClient that shares this client's
+// identity endpoint and HTTP settings. ReauthFunc implementations authenticate it
+// instead of the client itself, so that requests running concurrently aren't
+// disturbed, and then adopt the new token with CopyTokenFrom.
+func (client *ProviderClient) ReauthClient() *ProviderClient {
+	return &ProviderClient{
+		IdentityBase:      client.IdentityBase,
+		IdentityEndpoint:  client.IdentityEndpoint,
+		HTTPClient:        client.HTTPClient,
+		UserAgent:         client.UserAgent,
+		RetryPolicy:       client.RetryPolicy,
+		DisableKeepAlives: client.DisableKeepAlives,
+		Interceptors:      client.Interceptors,
+	}
+}
+
+// reauthenticate calls ReauthFunc after a request made with the token rejected
+// was refused. Concurrent callers that were refused the same token share a
+// single call to ReauthFunc, and callers whose token has already been replaced
+// return immediately so that they can retry with the new one.
+func (client *ProviderClient) reauthenticate(rejected string) error {
+	client.reauthMu.Lock()
+	if call := client.reauth; call != nil {
+		client.reauthMu.Unlock()
+		<-call.done
+		return call.err
+	}
+	if client.Token() != rejected {
+		client.reauthMu.Unlock()
+		return nil
+	}
+	call := &reauthCall{done: make(chan struct{})}
+	client.reauth = call
+	client.reauthMu.Unlock()
+
+	call.err = client.ReauthFunc()
+
+	client.reauthMu.Lock()
+	client.reauth = nil
+	client.reauthMu.Unlock()
+	close(call.done)
+
+	return call.err
+}
+
+// renewExpiringToken re-authenticates ahead of time if the current token is
+// about to expire. The current token is still valid at that point, so a
+// failure is not an error: the token stays in use, and the 401 it eventually
+// causes is handled like any other.
+func (client *ProviderClient) renewExpiringToken() {
+	if client.ReauthFunc == nil || client.TokenRenewalMargin < 0 {
+		return
+	}
+	margin := client.TokenRenewalMargin
+	if margin == 0 {
+		margin = DefaultTokenRenewalMargin
+	}
+
+	client.mu.RLock()
+	token, expiresAt := client.TokenID, client.tokenExpiresAt
+	client.mu.RUnlock()
+	if expiresAt.IsZero() || expiresAt.Sub(time.Now()) > margin {
+		return
+	}
+
+	// Leave it to the reauthentication in progress, if any. ReauthFunc may well be
+	// issuing this very request.
+	client.reauthMu.Lock()
+	busy := client.reauth != nil
+	client.reauthMu.Unlock()
+	if busy {
+		return
+	}
+
+	// If renewal fails, or the new token doesn't outlive the margin either
+	// (because tokens are short-lived, or the clocks disagree), stop renewing the
+	// token ahead of time and rely on 401 responses instead, rather than
+	// re-authenticating before every request.
+	err := client.reauthenticate(token)
+
+	client.mu.Lock()
+	if err != nil {
+		if client.TokenID == token {
+			client.tokenExpiresAt = time.Time{}
+		}
+	} else if !client.tokenExpiresAt.IsZero() && client.tokenExpiresAt.Sub(time.Now()) <= margin {
+		client.tokenExpiresAt = time.Time{}
+	}
+	client.mu.Unlock()
 }
 
 // RequestOpts customizes the behavior of the provider.Request() method.
@@ -100,6 +288,17 @@ type RequestOpts struct {
 	// provided with a blank value (""), that header will be *omitted* instead: use this to suppress
 	// the default Accept header or an inferred Content-Type, for example.
 	MoreHeaders map[string]string
+
+	// Context, if provided, bounds the lifetime of the request. Cancelling it or letting its deadline
+	// expire aborts the in-flight HTTP call and any reauthentication retry.
+	Context context.Context
+
+	// ServiceType identifies the service the request is addressed to. It's informational only, and is
+	// passed on to the ProviderClient's Interceptors. ServiceClient fills it in automatically.
+	ServiceType string
+
+	// reauthenticated is set on the retry issued after a successful reauthentication.
+	reauthenticated bool
 }
 
 func (opts *RequestOpts) setBody(body interface{}) {
@@ -110,23 +309,6 @@ func (opts *RequestOpts) setBody(body interface{}) {
 	}
 }
 
-// UnexpectedResponseCodeError is returned by the Request method when a response code other than
-// those listed in OkCodes is encountered.
-type UnexpectedResponseCodeError struct {
-	URL      string
-	Method   string
-	Expected []int
-	Actual   int
-	Body     []byte
-}
-
-func (err *UnexpectedResponseCodeError) Error() string {
-	return fmt.Sprintf(
-		"Expected HTTP response code %v when accessing [%s %s], but got %d instead\n%s",
-		err.Expected, err.Method, err.URL, err.Actual, err.Body,
-	)
-}
-
 var applicationJSON = "application/json"
 
 // Request performs an HTTP request using the ProviderClient's current HTTPClient. An authentication
@@ -135,6 +317,9 @@ func (client *ProviderClient) Request(method, url string, options RequestOpts) (
 	var body io.ReadSeeker
 	var contentType *string
 
+	// Renew the token if it's about to expire, rather than waiting for a 401.
+	client.renewExpiringToken()
+
 	// Derive the content body by either encoding an arbitrary object as JSON, or by taking a provided
 	// io.ReadSeeker as-is. Default the content-type to application/json.
 	if options.JSONBody != nil {
@@ -155,57 +340,79 @@ func (client *ProviderClient) Request(method, url string, options RequestOpts) (
 		body = options.RawBody
 	}
 
-	// Construct the http.Request.
-	req, err := http.NewRequest(method, url, body)
-	if err != nil {
-		return nil, err
+	// Allow default OkCodes if none explicitly set
+	if options.OkCodes == nil {
+		options.OkCodes = defaultOkCodes(method)
 	}
 
-	// Populate the request headers. Apply options.MoreHeaders last, to give the caller the chance to
-	// modify or omit any header.
-	if contentType != nil {
-		req.Header.Set("Content-Type", *contentType)
-	}
-	req.Header.Set("Accept", applicationJSON)
+	// Issue the request, retrying transient failures if a RetryPolicy is set.
+	var resp *http.Response
+	var token string
+	for attempt := 1; ; attempt++ {
+		req, err := client.newRequest(method, url, body, contentType, options)
+		if err != nil {
+			return nil, err
+		}
 
-	for k, v := range client.AuthenticatedHeaders() {
-		req.Header.Add(k, v)
-	}
+		info := RequestInfo{
+			ServiceType:     options.ServiceType,
+			OkCodes:         options.OkCodes,
+			Attempt:         attempt,
+			Reauthenticated: options.reauthenticated,
+		}
+		for _, i := range client.Interceptors {
+			i.BeforeRequest(req, info)
+		}
 
-	// Set the User-Agent header
-	req.Header.Set("User-Agent", client.UserAgent.Join())
+		token = req.Header.Get("X-Auth-Token")
+		resp, err = client.HTTPClient.Do(req)
 
-	if options.MoreHeaders != nil {
-		for k, v := range options.MoreHeaders {
-			if v != "" {
-				req.Header.Set(k, v)
-			} else {
-				req.Header.Del(k)
+		for i := len(client.Interceptors) - 1; i >= 0; i-- {
+			client.Interceptors[i].AfterResponse(req, resp, err, info)
+		}
+
+		delay, retry := client.RetryPolicy.shouldRetry(options.Context, attempt, method, resp, err)
+		if !retry {
+			if err != nil {
+				return nil, err
 			}
+			break
 		}
-	}
 
-	// Set connection parameter to close the connection immediately when we've got the response
-	req.Close = true
-	
-	// Issue the request.
-	resp, err := client.HTTPClient.Do(req)
-	if err != nil {
-		return nil, err
+		if resp != nil {
+			drainAndClose(resp)
+		}
+		if err := sleepContext(options.Context, delay); err != nil {
+			return nil, err
+		}
+		if body != nil {
+			body.Seek(0, 0)
+		}
 	}
 
-	if resp.StatusCode == http.StatusUnauthorized {
+	// Re-authenticate once, if possible, and try again.
+	if resp.StatusCode == http.StatusUnauthorized && !options.reauthenticated {
 		if client.ReauthFunc != nil {
-			err = client.ReauthFunc()
+			// Don't bother re-authenticating if the caller has already given up.
+			if options.Context != nil && options.Context.Err() != nil {
+				drainAndClose(resp)
+				return nil, options.Context.Err()
+			}
+			err := client.reauthenticate(token)
 			if err != nil {
 				return nil, fmt.Errorf("Error trying to re-authenticate: %s", err)
 			}
 			if options.RawBody != nil {
 				options.RawBody.Seek(0, 0)
 			}
-			resp.Body.Close()
+			drainAndClose(resp)
+			options.reauthenticated = true
 			resp, err = client.Request(method, url, options)
 			if err != nil {
+				// Pass HTTP errors through untouched so that callers can still inspect their type.
+				if _, ok := err.(StatusCodeError); ok {
+					return resp, err
+				}
 				return nil, fmt.Errorf("Successfully re-authenticated, but got error executing request: %s", err)
 			}
 
@@ -213,11 +420,6 @@ func (client *ProviderClient) Request(method, url string, options RequestOpts) (
 		}
 	}
 
-	// Allow default OkCodes if none explicitly set
-	if options.OkCodes == nil {
-		options.OkCodes = defaultOkCodes(method)
-	}
-
 	// Validate the HTTP response status.
 	var ok bool
 	for _, code := range options.OkCodes {
@@ -229,26 +431,71 @@ func (client *ProviderClient) Request(method, url string, options RequestOpts) (
 	if !ok {
 		body, _ := ioutil.ReadAll(resp.Body)
 		resp.Body.Close()
-		return resp, &UnexpectedResponseCodeError{
-			URL:      url,
-			Method:   method,
-			Expected: options.OkCodes,
-			Actual:   resp.StatusCode,
-			Body:     body,
-		}
+		return resp, newResponseCodeError(method, url, options.OkCodes, resp, body)
 	}
 
-	// Parse the response body as JSON, if requested to do so.
+	// Parse the response body as JSON, if requested to do so. Otherwise release it, so that its
+	// connection can be reused, unless it's the body of a GET, which the caller may want to stream.
 	if options.JSONResponse != nil {
-		defer resp.Body.Close()
+		defer drainAndClose(resp)
 		if err := json.NewDecoder(resp.Body).Decode(options.JSONResponse); err != nil {
 			return nil, err
 		}
+	} else if method != "GET" {
+		drainAndClose(resp)
 	}
 
 	return resp, nil
 }
 
+// newRequest constructs an http.Request for a single attempt of the Request method.
+func (client *ProviderClient) newRequest(method, url string, body io.ReadSeeker, contentType *string, options RequestOpts) (*http.Request, error) {
+	// Construct the http.Request.
+	req, err := http.NewRequest(method, url, body)
+	if err != nil {
+		return nil, err
+	}
+	if options.Context != nil {
+		req = req.WithContext(options.Context)
+	}
+
+	// Populate the request headers. Apply options.MoreHeaders last, to give the caller the chance to
+	// modify or omit any header.
+	if contentType != nil {
+		req.Header.Set("Content-Type", *contentType)
+	}
+	req.Header.Set("Accept", applicationJSON)
+
+	for k, v := range client.AuthenticatedHeaders() {
+		req.Header.Add(k, v)
+	}
+
+	// Set the User-Agent header
+	req.Header.Set("User-Agent", client.UserAgent.Join())
+
+	if options.MoreHeaders != nil {
+		for k, v := range options.MoreHeaders {
+			if v != "" {
+				req.Header.Set(k, v)
+			} else {
+				req.Header.Del(k)
+			}
+		}
+	}
+
+	// Close the connection immediately when we've got the response, if asked to.
+	req.Close = client.DisableKeepAlives
+
+	return req, nil
+}
+
+// drainAndClose reads whatever is left of a response body before closing it, so that the
+// underlying connection can be returned to the pool and reused.
+func drainAndClose(resp *http.Response) {
+	io.Copy(ioutil.Discard, resp.Body)
+	resp.Body.Close()
+}
+
 func defaultOkCodes(method string) []int {
 	switch {
 	case method == "GET":
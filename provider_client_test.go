@@ -1,7 +1,14 @@
 package gophercloud
 
 import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	th "github.com/rackspace/gophercloud/testhelper"
 )
@@ -33,3 +40,307 @@ func TestUserAgent(t *testing.T) {
 	actual = p.UserAgent.Join()
 	th.CheckEquals(t, expected, actual)
 }
+
+func TestRequestWithCancelledContext(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		// Hang until the client gives up.
+		<-r.Context().Done()
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	p := &ProviderClient{}
+	_, err := p.Request("GET", th.Endpoint(), RequestOpts{Context: ctx})
+	th.AssertErr(t, err)
+	th.CheckEquals(t, context.DeadlineExceeded, ctx.Err())
+}
+
+func TestReauthRetryHonorsContext(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	hits := 0
+	th.Mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	p := &ProviderClient{
+		ReauthFunc: func() error {
+			// The caller gives up while we're re-authenticating.
+			cancel()
+			return nil
+		},
+	}
+	_, err := p.Request("GET", th.Endpoint(), RequestOpts{Context: ctx})
+	th.AssertErr(t, err)
+	th.CheckEquals(t, 1, hits)
+}
+
+// countingClient returns an http.Client that counts the connections it dials.
+func countingClient(dials *int32) http.Client {
+	return http.Client{
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
+				atomic.AddInt32(dials, 1)
+				return (&net.Dialer{}).DialContext(ctx, network, addr)
+			},
+		},
+	}
+}
+
+func handleKeepAlive() {
+	th.Mux.HandleFunc("/resource", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case "GET":
+			fmt.Fprintf(w, `{"resource": {"id": "1234", "padding": "%0512d"}}`, 0)
+		case "POST":
+			w.WriteHeader(http.StatusAccepted)
+			fmt.Fprintf(w, `{"status": "updating", "padding": "%0512d"}`, 0)
+		case "DELETE":
+			w.WriteHeader(http.StatusAccepted)
+			fmt.Fprintf(w, `{"status": "deleting"}`)
+		}
+	})
+}
+
+func TestKeepAlive(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	handleKeepAlive()
+
+	var dials int32
+	p := &ProviderClient{HTTPClient: countingClient(&dials)}
+
+	for i := 0; i < 5; i++ {
+		var body interface{}
+		_, err := p.Get(th.Endpoint()+"resource", &body, nil)
+		th.AssertNoErr(t, err)
+
+		// The response body isn't requested here, but must still be released.
+		_, err = p.Delete(th.Endpoint()+"resource", nil)
+		th.AssertNoErr(t, err)
+	}
+	th.CheckEquals(t, int32(1), atomic.LoadInt32(&dials))
+
+	dials = 0
+	p = &ProviderClient{HTTPClient: countingClient(&dials), DisableKeepAlives: true}
+	for i := 0; i < 5; i++ {
+		var body interface{}
+		_, err := p.Get(th.Endpoint()+"resource", &body, nil)
+		th.AssertNoErr(t, err)
+	}
+	th.CheckEquals(t, int32(5), atomic.LoadInt32(&dials))
+}
+
+func TestKeepAliveUnparsedRequests(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	handleKeepAlive()
+
+	var dials int32
+	p := &ProviderClient{HTTPClient: countingClient(&dials)}
+
+	for i := 0; i < 5; i++ {
+		// Callers of Request that don't ask for the body and throw the response away mustn't
+		// hold on to the connection.
+		_, err := p.Request("POST", th.Endpoint()+"resource", RequestOpts{JSONBody: map[string]string{}})
+		th.AssertNoErr(t, err)
+
+		_, err = p.Request("DELETE", th.Endpoint()+"resource", RequestOpts{})
+		th.AssertNoErr(t, err)
+	}
+	th.CheckEquals(t, int32(1), atomic.LoadInt32(&dials))
+}
+
+func benchmarkRequests(b *testing.B, disableKeepAlives bool) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	handleKeepAlive()
+
+	var dials int32
+	p := &ProviderClient{HTTPClient: countingClient(&dials), DisableKeepAlives: disableKeepAlives}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var body interface{}
+		if _, err := p.Get(th.Endpoint()+"resource", &body, nil); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+
+	b.Logf("%d requests opened %d connections", b.N, atomic.LoadInt32(&dials))
+}
+
+func BenchmarkRequestKeepAlive(b *testing.B) {
+	benchmarkRequests(b, false)
+}
+
+func BenchmarkRequestDisableKeepAlives(b *testing.B) {
+	benchmarkRequests(b, true)
+}
+
+func TestConcurrentRequestsShareReauthentication(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Auth-Token") != "fresh" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	var reauths int32
+	p := &ProviderClient{TokenID: "stale"}
+	p.ReauthFunc = func() error {
+		atomic.AddInt32(&reauths, 1)
+		// Give the other goroutines a chance to pile up behind this call.
+		time.Sleep(50 * time.Millisecond)
+		p.SetToken("fresh")
+		return nil
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := p.Request("GET", th.Endpoint(), RequestOpts{})
+			th.CheckNoErr(t, err)
+		}()
+	}
+	wg.Wait()
+
+	th.CheckEquals(t, int32(1), atomic.LoadInt32(&reauths))
+	th.CheckEquals(t, "fresh", p.Token())
+}
+
+func TestReauthenticateOnlyOnce(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+
+	reauths := 0
+	p := &ProviderClient{TokenID: "stale"}
+	p.ReauthFunc = func() error {
+		reauths++
+		p.SetToken(fmt.Sprintf("token-%d", reauths))
+		return nil
+	}
+
+	_, err := p.Request("GET", th.Endpoint(), RequestOpts{})
+	if !IsUnauthorized(err) {
+		t.Errorf("Expected an UnauthorizedError, got %#v", err)
+	}
+	th.CheckEquals(t, 1, reauths)
+}
+
+func TestTokenRenewalMargin(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	reauths := 0
+	p := &ProviderClient{TokenRenewalMargin: 10 * time.Minute}
+	p.ReauthFunc = func() error {
+		reauths++
+		p.SetToken(fmt.Sprintf("token-%d", reauths))
+		p.SetTokenExpiresAt(time.Now().Add(time.Hour))
+		return nil
+	}
+	p.SetToken("token-0")
+
+	// A token with an unknown expiry is left alone.
+	_, err := p.Request("GET", th.Endpoint(), RequestOpts{})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 0, reauths)
+
+	// So is one that outlives the margin.
+	p.SetTokenExpiresAt(time.Now().Add(20 * time.Minute))
+	_, err = p.Request("GET", th.Endpoint(), RequestOpts{})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 0, reauths)
+
+	// One that expires within the margin is renewed before the request is sent.
+	p.SetTokenExpiresAt(time.Now().Add(5 * time.Minute))
+	_, err = p.Request("GET", th.Endpoint(), RequestOpts{})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 1, reauths)
+	th.CheckEquals(t, "token-1", p.Token())
+
+	// Renewal can be disabled.
+	p.TokenRenewalMargin = -1
+	p.SetTokenExpiresAt(time.Now().Add(time.Minute))
+	_, err = p.Request("GET", th.Endpoint(), RequestOpts{})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 1, reauths)
+}
+
+func TestShortLivedTokensAreNotRenewedRepeatedly(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	reauths := 0
+	p := &ProviderClient{}
+	p.ReauthFunc = func() error {
+		reauths++
+		p.SetToken(fmt.Sprintf("token-%d", reauths))
+		p.SetTokenExpiresAt(time.Now().Add(time.Minute))
+		return nil
+	}
+	p.SetToken("token-0")
+	p.SetTokenExpiresAt(time.Now().Add(time.Minute))
+
+	for i := 0; i < 3; i++ {
+		_, err := p.Request("GET", th.Endpoint(), RequestOpts{})
+		th.AssertNoErr(t, err)
+	}
+	th.CheckEquals(t, 1, reauths)
+}
+
+func TestFailedTokenRenewalKeepsCurrentToken(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", "token-0")
+		w.WriteHeader(http.StatusOK)
+	})
+
+	reauths := 0
+	p := &ProviderClient{}
+	p.ReauthFunc = func() error {
+		reauths++
+		return fmt.Errorf("identity service unavailable")
+	}
+	p.SetToken("token-0")
+	p.SetTokenExpiresAt(time.Now().Add(time.Minute))
+
+	// The token is still valid, so the requests go ahead with it, and renewal isn't attempted
+	// again before every one of them.
+	for i := 0; i < 3; i++ {
+		_, err := p.Request("GET", th.Endpoint(), RequestOpts{})
+		th.AssertNoErr(t, err)
+	}
+	th.CheckEquals(t, 1, reauths)
+	th.CheckEquals(t, "token-0", p.Token())
+}
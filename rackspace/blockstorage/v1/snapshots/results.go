@@ -110,38 +110,38 @@ func ExtractSnapshots(page pagination.Page) ([]Snapshot, error) {
 
 // WaitUntilComplete will continually poll a snapshot until it successfully
 // transitions to a specified state. It will do this for at most the number of
-// seconds specified.
+// seconds specified, and gives up as soon as the snapshot goes into the ERROR state.
 func (snapshot Snapshot) WaitUntilComplete(c *gophercloud.ServiceClient, timeout int) error {
-	return gophercloud.WaitFor(timeout, func() (bool, error) {
-		// Poll resource
+	w := gophercloud.Waiter{
+		Target:  []string{"100%"},
+		Failure: []string{string(Error)},
+	}
+	return w.WaitSeconds(c.Context, timeout, func() (string, error) {
 		current, err := Get(c, snapshot.ID).Extract()
 		if err != nil {
-			return false, err
+			return "", err
 		}
 
-		// Has it been built yet?
-		if current.Progress == "100%" {
-			return true, nil
+		// A snapshot has been built once its progress reaches 100%, unless it failed along the way.
+		if current.Status == Error {
+			return string(current.Status), nil
 		}
-
-		return false, nil
+		return current.Progress, nil
 	})
 }
 
 // WaitUntilDeleted will continually poll a snapshot until it has been
 // successfully deleted, i.e. returns a 404 status.
 func (snapshot Snapshot) WaitUntilDeleted(c *gophercloud.ServiceClient, timeout int) error {
-	return gophercloud.WaitFor(timeout, func() (bool, error) {
-		// Poll resource
-		_, err := Get(c, snapshot.ID).Extract()
-
-		// Check for a 404
-		if casted, ok := err.(*gophercloud.UnexpectedResponseCodeError); ok && casted.Actual == 404 {
-			return true, nil
-		} else if err != nil {
-			return false, err
+	w := gophercloud.Waiter{
+		Failure:      []string{string(DeleteError)},
+		UntilDeleted: true,
+	}
+	return w.WaitSeconds(c.Context, timeout, func() (string, error) {
+		current, err := Get(c, snapshot.ID).Extract()
+		if err != nil {
+			return "", err
 		}
-
-		return false, nil
+		return string(current.Status), nil
 	})
 }
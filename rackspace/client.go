@@ -53,8 +53,12 @@ func AuthenticatedClient(options gophercloud.AuthOptions) (*gophercloud.Provider
 }
 
 // Authenticate or re-authenticate against the most recent identity service supported at the
-// provided endpoint.
+// provided endpoint. If options.TokenStore holds a valid token for the same user, it's used instead.
 func Authenticate(client *gophercloud.ProviderClient, options gophercloud.AuthOptions) error {
+	if os.LoadToken(client, options, v20, v2auth) {
+		return nil
+	}
+
 	versions := []*utils.Version{
 		&utils.Version{ID: v20, Priority: 20, Suffix: "/v2.0/"},
 	}
@@ -73,8 +77,12 @@ func Authenticate(client *gophercloud.ProviderClient, options gophercloud.AuthOp
 	}
 }
 
-// AuthenticateV2 explicitly authenticates with v2 of the identity service.
+// AuthenticateV2 explicitly authenticates with v2 of the identity service. If options.TokenStore
+// holds a valid token for the same user, it's used instead.
 func AuthenticateV2(client *gophercloud.ProviderClient, options gophercloud.AuthOptions) error {
+	if os.LoadToken(client, options, v20, v2auth) {
+		return nil
+	}
 	return v2auth(client, "", options)
 }
 
@@ -96,15 +104,24 @@ func v2auth(client *gophercloud.ProviderClient, endpoint string, options gopherc
 		return err
 	}
 
+	os.StoreToken(client, options, v20, v2Client.Endpoint, token.ID, token.ExpiresAt, catalog)
+
 	if options.AllowReauth {
 		client.ReauthFunc = func() error {
-			return AuthenticateV2(client, options)
+			tac := client.ReauthClient()
+			if err := v2auth(tac, endpoint, options); err != nil {
+				return err
+			}
+			client.CopyTokenFrom(tac)
+			return nil
 		}
 	}
-	client.TokenID = token.ID
+	client.SetToken(token.ID)
+	client.SetTokenExpiresAt(token.ExpiresAt)
 	client.EndpointLocator = func(opts gophercloud.EndpointOpts) (string, error) {
 		return os.V2EndpointURL(catalog, opts)
 	}
+	client.ServiceCatalog = os.V2ServiceCatalog(catalog)
 
 	return nil
 }
@@ -116,6 +133,7 @@ func NewIdentityV2(client *gophercloud.ProviderClient) *gophercloud.ServiceClien
 	return &gophercloud.ServiceClient{
 		ProviderClient: client,
 		Endpoint:       v2Endpoint,
+		Type:           "identity",
 	}
 }
 
@@ -130,6 +148,7 @@ func NewComputeV2(client *gophercloud.ProviderClient, eo gophercloud.EndpointOpt
 	return &gophercloud.ServiceClient{
 		ProviderClient: client,
 		Endpoint:       url,
+		Type:           eo.Type,
 	}, nil
 }
 
@@ -140,7 +159,7 @@ func NewObjectCDNV1(client *gophercloud.ProviderClient, eo gophercloud.EndpointO
 	if err != nil {
 		return nil, err
 	}
-	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url}, nil
+	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url, Type: eo.Type}, nil
 }
 
 // NewObjectStorageV1 creates a ServiceClient that may be used with the Rackspace v1 object storage package.
@@ -157,7 +176,7 @@ func NewBlockStorageV1(client *gophercloud.ProviderClient, eo gophercloud.Endpoi
 		return nil, err
 	}
 
-	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url}, nil
+	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url, Type: eo.Type}, nil
 }
 
 // NewLBV1 creates a ServiceClient that can be used to access the Rackspace
@@ -168,7 +187,7 @@ func NewLBV1(client *gophercloud.ProviderClient, eo gophercloud.EndpointOpts) (*
 	if err != nil {
 		return nil, err
 	}
-	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url}, nil
+	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url, Type: eo.Type}, nil
 }
 
 // NewNetworkV2 creates a ServiceClient that can be used to access the Rackspace
@@ -179,7 +198,7 @@ func NewNetworkV2(client *gophercloud.ProviderClient, eo gophercloud.EndpointOpt
 	if err != nil {
 		return nil, err
 	}
-	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url}, nil
+	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url, Type: eo.Type}, nil
 }
 
 // NewCDNV1 creates a ServiceClient that may be used to access the Rackspace v1
@@ -190,7 +209,7 @@ func NewCDNV1(client *gophercloud.ProviderClient, eo gophercloud.EndpointOpts) (
 	if err != nil {
 		return nil, err
 	}
-	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url}, nil
+	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url, Type: eo.Type}, nil
 }
 
 // NewOrchestrationV1 creates a ServiceClient that may be used to access the v1 orchestration service.
@@ -200,7 +219,7 @@ func NewOrchestrationV1(client *gophercloud.ProviderClient, eo gophercloud.Endpo
 	if err != nil {
 		return nil, err
 	}
-	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url}, nil
+	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url, Type: eo.Type}, nil
 }
 
 // NewRackConnectV3 creates a ServiceClient that may be used to access the v3 RackConnect service.
@@ -210,7 +229,7 @@ func NewRackConnectV3(client *gophercloud.ProviderClient, eo gophercloud.Endpoin
 	if err != nil {
 		return nil, err
 	}
-	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url}, nil
+	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url, Type: eo.Type}, nil
 }
 
 // NewDBV1 creates a ServiceClient that may be used to access the v1 DB service.
@@ -220,7 +239,7 @@ func NewDBV1(client *gophercloud.ProviderClient, eo gophercloud.EndpointOpts) (*
 	if err != nil {
 		return nil, err
 	}
-	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url}, nil
+	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url, Type: eo.Type}, nil
 }
 
 // NewAutoScaleV1 creates a ServiceClient that may be used to access the v1 Auto Scale service.
@@ -230,5 +249,5 @@ func NewAutoScaleV1(client *gophercloud.ProviderClient, eo gophercloud.EndpointO
 	if err != nil {
 		return nil, err
 	}
-	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url}, nil
+	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url, Type: eo.Type}, nil
 }
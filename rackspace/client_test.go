@@ -2,8 +2,11 @@ package rackspace
 
 import (
 	"fmt"
+	"io/ioutil"
 	"net/http"
+	"os"
 	"testing"
+	"time"
 
 	"github.com/rackspace/gophercloud"
 	th "github.com/rackspace/gophercloud/testhelper"
@@ -36,3 +39,78 @@ func TestAuthenticatedClientV2(t *testing.T) {
 	th.AssertNoErr(t, err)
 	th.CheckEquals(t, "01234567890", client.TokenID)
 }
+
+func TestAuthenticatedClientRecordsTokenExpiry(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	issued := 0
+	th.Mux.HandleFunc("/v2.0/tokens", func(w http.ResponseWriter, r *http.Request) {
+		issued++
+		expires := time.Now().UTC().Add(time.Minute).Format(gophercloud.RFC3339Milli)
+		if issued > 1 {
+			expires = "2999-10-01T10:00:00.000000Z"
+		}
+		fmt.Fprintf(w, `{"access": {"token": {"id": "token-%d", "expires": "%s"}, "serviceCatalog": []}}`, issued, expires)
+	})
+
+	th.Mux.HandleFunc("/resource", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", "token-2")
+		w.WriteHeader(http.StatusOK)
+	})
+
+	options := gophercloud.AuthOptions{
+		Username:         "me",
+		APIKey:           "09876543210",
+		IdentityEndpoint: th.Endpoint() + "v2.0/",
+		AllowReauth:      true,
+	}
+	client, err := AuthenticatedClient(options)
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "token-1", client.Token())
+
+	_, err = client.Request("GET", th.Endpoint()+"resource", gophercloud.RequestOpts{})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 2, issued)
+
+	expected, _ := time.Parse(gophercloud.RFC3339Milli, "2999-10-01T10:00:00.000000Z")
+	th.CheckEquals(t, expected, client.TokenExpiresAt())
+}
+
+func TestAuthenticatedClientTokenStore(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	issued := 0
+	th.Mux.HandleFunc("/v2.0/tokens", func(w http.ResponseWriter, r *http.Request) {
+		issued++
+		th.TestJSONRequest(t, r, `{"auth": {"RAX-KSKEY:apiKeyCredentials": {"username": "me", "apiKey": "09876543210"}}}`)
+		fmt.Fprintf(w, `{"access": {"token": {"id": "token-%d", "expires": "2999-10-01T10:00:00.000000Z"}, "serviceCatalog": []}}`, issued)
+	})
+
+	dir, err := ioutil.TempDir("", "gophercloud-tokens")
+	th.AssertNoErr(t, err)
+	defer os.RemoveAll(dir)
+
+	options := gophercloud.AuthOptions{
+		Username:         "me",
+		APIKey:           "09876543210",
+		IdentityEndpoint: th.Endpoint() + "v2.0/",
+		AllowReauth:      true,
+		TokenStore:       &gophercloud.FileTokenStore{Dir: dir},
+	}
+	client, err := AuthenticatedClient(options)
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "token-1", client.Token())
+
+	// A second process reuses the stored token without asking the identity service.
+	client, err = AuthenticatedClient(options)
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "token-1", client.Token())
+	th.CheckEquals(t, 1, issued)
+
+	// It still reauthenticates with the API key.
+	th.AssertNoErr(t, client.ReauthFunc())
+	th.CheckEquals(t, "token-2", client.Token())
+	th.CheckEquals(t, 2, issued)
+}
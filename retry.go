@@ -0,0 +1,171 @@
+package gophercloud
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	// DefaultRetryBaseDelay is the backoff used before the first retry when RetryPolicy.BaseDelay is unset.
+	DefaultRetryBaseDelay = 1 * time.Second
+
+	// DefaultRetryMaxDelay caps the backoff between two attempts when RetryPolicy.MaxDelay is unset.
+	DefaultRetryMaxDelay = 30 * time.Second
+)
+
+/*
+RetryPolicy describes how a ProviderClient retries requests that failed for a
+transient reason, such as a rate-limit response, an overloaded API node or a
+dropped connection. Attach one to ProviderClient.RetryPolicy to enable it;
+a nil policy means every request is attempted exactly once.
+
+Between attempts the client waits for an exponentially growing, jittered
+delay, or for the duration requested by a Retry-After response header when
+one is present. A RawBody is rewound before each new attempt, just as it is
+when re-authenticating.
+
+  provider.RetryPolicy = &gophercloud.RetryPolicy{
+    MaxAttempts: 5,
+    BaseDelay:   500 * time.Millisecond,
+  }
+*/
+type RetryPolicy struct {
+	// MaxAttempts is the total number of times a request may be issued, including the first one.
+	// Values below 2 disable retries.
+	MaxAttempts int
+
+	// BaseDelay is the delay before the first retry. It doubles with each subsequent attempt.
+	// Defaults to DefaultRetryBaseDelay.
+	BaseDelay time.Duration
+
+	// MaxDelay caps the delay between two attempts, including any delay requested by Retry-After.
+	// Defaults to DefaultRetryMaxDelay.
+	MaxDelay time.Duration
+
+	// RetryNonIdempotent allows POST and PATCH requests to be retried as well. Only enable this if
+	// repeating such a request cannot create duplicate resources.
+	RetryNonIdempotent bool
+
+	// Retryable decides whether an attempt should be retried. Exactly one of resp and err is non-nil.
+	// When left as nil, DefaultRetryable is used.
+	Retryable func(resp *http.Response, err error) bool
+}
+
+// DefaultRetryable retries on transport errors, 429 Too Many Requests, 502, 503 and 504 responses, and
+// on 413 responses that carry a Retry-After header, which is how Nova signals rate limiting.
+func DefaultRetryable(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	case http.StatusRequestEntityTooLarge:
+		return resp.Header.Get("Retry-After") != ""
+	}
+	return false
+}
+
+// shouldRetry reports whether attempt number attempt of a method request, which ended with either
+// resp or err, should be issued again, and how long to wait beforehand.
+func (p *RetryPolicy) shouldRetry(ctx context.Context, attempt int, method string, resp *http.Response, err error) (time.Duration, bool) {
+	if p == nil || attempt >= p.MaxAttempts {
+		return 0, false
+	}
+
+	// There's no point in retrying once the caller has given up.
+	if ctx != nil && ctx.Err() != nil {
+		return 0, false
+	}
+
+	switch method {
+	case "POST", "PATCH":
+		if !p.RetryNonIdempotent {
+			return 0, false
+		}
+	}
+
+	retryable := p.Retryable
+	if retryable == nil {
+		retryable = DefaultRetryable
+	}
+	if !retryable(resp, err) {
+		return 0, false
+	}
+
+	return p.delay(attempt, resp), true
+}
+
+// delay computes the backoff to apply after attempt number attempt.
+func (p *RetryPolicy) delay(attempt int, resp *http.Response) time.Duration {
+	base, max := p.BaseDelay, p.MaxDelay
+	if base <= 0 {
+		base = DefaultRetryBaseDelay
+	}
+	if max <= 0 {
+		max = DefaultRetryMaxDelay
+	}
+
+	if resp != nil {
+		if d, ok := retryAfter(resp.Header.Get("Retry-After")); ok {
+			if d > max {
+				return max
+			}
+			return d
+		}
+	}
+
+	d := base
+	for i := 1; i < attempt && d < max; i++ {
+		d *= 2
+	}
+	if d > max {
+		d = max
+	}
+
+	// Apply "equal jitter": wait at least half of the computed delay, plus a random share of the rest.
+	half := d / 2
+	return half + time.Duration(rand.Int63n(int64(d-half)+1))
+}
+
+// retryAfter parses a Retry-After header value, given either in seconds or as an HTTP date.
+func retryAfter(v string) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(v); err == nil {
+		if secs < 0 {
+			return 0, false
+		}
+		return time.Duration(secs) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		d := t.Sub(time.Now())
+		if d < 0 {
+			d = 0
+		}
+		return d, true
+	}
+	return 0, false
+}
+
+// sleepContext waits for d, returning early with ctx's error if ctx is done first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if ctx == nil {
+		time.Sleep(d)
+		return nil
+	}
+
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
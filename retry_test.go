@@ -0,0 +1,153 @@
+package gophercloud
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	th "github.com/rackspace/gophercloud/testhelper"
+)
+
+func TestRetryOnServiceUnavailable(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	hits := 0
+	th.Mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		if hits < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	p := &ProviderClient{
+		RetryPolicy: &RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond},
+	}
+	_, err := p.Request("GET", th.Endpoint(), RequestOpts{})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 3, hits)
+}
+
+func TestRetryGivesUpAfterMaxAttempts(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	hits := 0
+	th.Mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	p := &ProviderClient{
+		RetryPolicy: &RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond},
+	}
+	_, err := p.Request("GET", th.Endpoint(), RequestOpts{})
+	if _, ok := err.(*TooManyRequestsError); !ok {
+		t.Fatalf("Expected a TooManyRequestsError, got %#v", err)
+	}
+	th.CheckEquals(t, 2, hits)
+}
+
+func TestRetrySkipsNonIdempotentMethods(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	hits := 0
+	th.Mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	p := &ProviderClient{
+		RetryPolicy: &RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond},
+	}
+	_, err := p.Request("POST", th.Endpoint(), RequestOpts{})
+	th.AssertErr(t, err)
+	th.CheckEquals(t, 1, hits)
+
+	hits = 0
+	p.RetryPolicy.RetryNonIdempotent = true
+	_, err = p.Request("POST", th.Endpoint(), RequestOpts{})
+	th.AssertErr(t, err)
+	th.CheckEquals(t, 3, hits)
+}
+
+func TestRetryRewindsRawBody(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	var bodies []string
+	th.Mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, string(b))
+		if len(bodies) == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	p := &ProviderClient{
+		RetryPolicy: &RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond},
+	}
+	_, err := p.Request("PUT", th.Endpoint(), RequestOpts{RawBody: strings.NewReader("payload")})
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []string{"payload", "payload"}, bodies)
+}
+
+func TestRetryableHook(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	hits := 0
+	th.Mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		w.WriteHeader(http.StatusConflict)
+	})
+
+	p := &ProviderClient{
+		RetryPolicy: &RetryPolicy{
+			MaxAttempts: 4,
+			BaseDelay:   time.Millisecond,
+			Retryable: func(resp *http.Response, err error) bool {
+				return resp != nil && resp.StatusCode == http.StatusConflict
+			},
+		},
+	}
+	_, err := p.Request("DELETE", th.Endpoint(), RequestOpts{})
+	th.AssertErr(t, err)
+	th.CheckEquals(t, 4, hits)
+}
+
+func TestRetryDelay(t *testing.T) {
+	p := &RetryPolicy{BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second}
+
+	for attempt, max := range map[int]time.Duration{1: 100, 2: 200, 3: 400, 4: 800, 5: 1000, 10: 1000} {
+		max *= time.Millisecond
+		d := p.delay(attempt, nil)
+		if d < max/2 || d > max {
+			t.Errorf("Attempt %d: expected a delay between %s and %s, got %s", attempt, max/2, max, d)
+		}
+	}
+
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"0"}}}
+	th.CheckEquals(t, time.Duration(0), p.delay(3, resp))
+
+	resp.Header.Set("Retry-After", "120")
+	th.CheckEquals(t, time.Second, p.delay(1, resp))
+}
+
+func TestDefaultRetryable(t *testing.T) {
+	resp := &http.Response{StatusCode: http.StatusRequestEntityTooLarge, Header: http.Header{}}
+	th.CheckEquals(t, false, DefaultRetryable(resp, nil))
+
+	resp.Header.Set("Retry-After", "5")
+	th.CheckEquals(t, true, DefaultRetryable(resp, nil))
+
+	resp = &http.Response{StatusCode: http.StatusNotFound, Header: http.Header{}}
+	th.CheckEquals(t, false, DefaultRetryable(resp, nil))
+}
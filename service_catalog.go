@@ -0,0 +1,125 @@
+package gophercloud
+
+import "sort"
+
+// CatalogEndpoint is a single URL at which a service can be reached.
+type CatalogEndpoint struct {
+	// Region is the region the endpoint serves. It's empty for services that don't span regions.
+	Region string
+
+	// Availability indicates who can reach the endpoint: everyone, the cloud's internal network, or
+	// administrators.
+	Availability Availability
+
+	// URL is the endpoint's base URL.
+	URL string
+}
+
+// CatalogService is a service listed in a ServiceCatalog.
+type CatalogService struct {
+	// Type is the kind of service, such as "compute" or "object-store".
+	Type string
+
+	// Name is the name the provider assigned to the service, such as "nova".
+	Name string
+
+	// Endpoints lists every endpoint of the service, one per region and availability.
+	Endpoints []CatalogEndpoint
+}
+
+// ServiceCatalog lists the services available to an authenticated user, as reported by the
+// identity service alongside their token. It has the same shape whichever version of the identity
+// service issued it: identity v2 endpoints, which carry a URL per availability, are split into one
+// CatalogEndpoint per URL.
+type ServiceCatalog struct {
+	Services []CatalogService
+}
+
+// matches returns true if the service and endpoint satisfy every non-empty field of opts.
+func (opts EndpointOpts) matches(service CatalogService, endpoint CatalogEndpoint) bool {
+	return (opts.Type == "" || opts.Type == service.Type) &&
+		(opts.Name == "" || opts.Name == service.Name) &&
+		(opts.Region == "" || opts.Region == endpoint.Region) &&
+		(opts.Availability == "" || opts.Availability == endpoint.Availability)
+}
+
+// Find returns the services that have at least one endpoint matching opts, each with only its
+// matching endpoints. Unlike an EndpointLocator, it doesn't expect opts to identify a single
+// endpoint, and doesn't apply any defaults: empty fields match anything. For example, this lists
+// every service offered to the public in a region:
+//
+//   catalog.Find(gophercloud.EndpointOpts{Region: "RegionOne", Availability: gophercloud.AvailabilityPublic})
+func (c *ServiceCatalog) Find(opts EndpointOpts) []CatalogService {
+	if c == nil {
+		return nil
+	}
+
+	var found []CatalogService
+	for _, service := range c.Services {
+		var endpoints []CatalogEndpoint
+		for _, endpoint := range service.Endpoints {
+			if opts.matches(service, endpoint) {
+				endpoints = append(endpoints, endpoint)
+			}
+		}
+		if len(endpoints) > 0 {
+			service.Endpoints = endpoints
+			found = append(found, service)
+		}
+	}
+	return found
+}
+
+// Has returns true if any endpoint in the catalog matches opts, with empty fields matching
+// anything. Use it to find out whether a service client can be created in a region:
+//
+//   if provider.ServiceCatalog.Has(gophercloud.EndpointOpts{Type: "volumev2", Region: "RegionOne"}) {
+//     client, err := openstack.NewBlockStorageV2(provider, gophercloud.EndpointOpts{Region: "RegionOne"})
+//     ...
+//   }
+func (c *ServiceCatalog) Has(opts EndpointOpts) bool {
+	return len(c.Find(opts)) > 0
+}
+
+// Types returns the distinct service types in the catalog, sorted alphabetically.
+func (c *ServiceCatalog) Types() []string {
+	return c.collect(func(s CatalogService, e CatalogEndpoint) string { return s.Type })
+}
+
+// Regions returns the distinct regions in which any service has an endpoint, sorted alphabetically.
+func (c *ServiceCatalog) Regions() []string {
+	return c.collect(func(s CatalogService, e CatalogEndpoint) string { return e.Region })
+}
+
+// Availabilities returns the distinct availabilities (or "interfaces", in identity v3 terms) of
+// the endpoints in the catalog, sorted alphabetically.
+func (c *ServiceCatalog) Availabilities() []Availability {
+	values := c.collect(func(s CatalogService, e CatalogEndpoint) string { return string(e.Availability) })
+
+	availabilities := make([]Availability, len(values))
+	for i, v := range values {
+		availabilities[i] = Availability(v)
+	}
+	return availabilities
+}
+
+// collect returns the distinct, non-empty values of a property of the catalog's endpoints.
+func (c *ServiceCatalog) collect(property func(CatalogService, CatalogEndpoint) string) []string {
+	if c == nil {
+		return []string{}
+	}
+
+	seen := make(map[string]bool)
+	values := []string{}
+	for _, service := range c.Services {
+		for _, endpoint := range service.Endpoints {
+			v := property(service, endpoint)
+			if v != "" && !seen[v] {
+				seen[v] = true
+				values = append(values, v)
+			}
+		}
+	}
+	sort.Strings(values)
+	return values
+}
@@ -0,0 +1,71 @@
+package gophercloud
+
+import (
+	"testing"
+
+	th "github.com/rackspace/gophercloud/testhelper"
+)
+
+var serviceCatalog = &ServiceCatalog{
+	Services: []CatalogService{
+		{
+			Type: "compute",
+			Name: "nova",
+			Endpoints: []CatalogEndpoint{
+				{Region: "RegionOne", Availability: AvailabilityPublic, URL: "https://compute.one.example.com/"},
+				{Region: "RegionOne", Availability: AvailabilityInternal, URL: "https://compute.one.internal/"},
+				{Region: "RegionTwo", Availability: AvailabilityPublic, URL: "https://compute.two.example.com/"},
+			},
+		},
+		{
+			Type: "object-store",
+			Name: "swift",
+			Endpoints: []CatalogEndpoint{
+				{Region: "RegionTwo", Availability: AvailabilityPublic, URL: "https://storage.two.example.com/"},
+			},
+		},
+		{
+			Type: "identity",
+			Name: "keystone",
+			Endpoints: []CatalogEndpoint{
+				{Availability: AvailabilityAdmin, URL: "https://identity.example.com/"},
+			},
+		},
+	},
+}
+
+func TestServiceCatalogEnumeration(t *testing.T) {
+	th.CheckDeepEquals(t, []string{"compute", "identity", "object-store"}, serviceCatalog.Types())
+	th.CheckDeepEquals(t, []string{"RegionOne", "RegionTwo"}, serviceCatalog.Regions())
+	th.CheckDeepEquals(t, []Availability{AvailabilityAdmin, AvailabilityInternal, AvailabilityPublic}, serviceCatalog.Availabilities())
+}
+
+func TestServiceCatalogFind(t *testing.T) {
+	found := serviceCatalog.Find(EndpointOpts{Region: "RegionTwo"})
+	th.CheckDeepEquals(t, []CatalogService{
+		{
+			Type:      "compute",
+			Name:      "nova",
+			Endpoints: []CatalogEndpoint{{Region: "RegionTwo", Availability: AvailabilityPublic, URL: "https://compute.two.example.com/"}},
+		},
+		{
+			Type:      "object-store",
+			Name:      "swift",
+			Endpoints: []CatalogEndpoint{{Region: "RegionTwo", Availability: AvailabilityPublic, URL: "https://storage.two.example.com/"}},
+		},
+	}, found)
+
+	th.CheckEquals(t, true, serviceCatalog.Has(EndpointOpts{Type: "compute", Availability: AvailabilityInternal}))
+	th.CheckEquals(t, false, serviceCatalog.Has(EndpointOpts{Type: "object-store", Region: "RegionOne"}))
+	th.CheckEquals(t, false, serviceCatalog.Has(EndpointOpts{Type: "compute", Name: "swift"}))
+
+	// The catalog's original endpoints are left untouched.
+	th.CheckEquals(t, 3, len(serviceCatalog.Services[0].Endpoints))
+}
+
+func TestNilServiceCatalog(t *testing.T) {
+	var catalog *ServiceCatalog
+	th.CheckEquals(t, false, catalog.Has(EndpointOpts{Type: "compute"}))
+	th.CheckDeepEquals(t, []string{}, catalog.Types())
+	th.CheckDeepEquals(t, []Availability{}, catalog.Availabilities())
+}
@@ -1,6 +1,15 @@
 package gophercloud
 
-import "strings"
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+)
+
+// ErrMicroversionWithoutType is returned by requests through a ServiceClient whose Microversion is
+// set but whose Type isn't, since the header that requests a microversion depends on the Type.
+var ErrMicroversionWithoutType = errors.New("A microversion can't be requested without the ServiceClient's Type.")
 
 // ServiceClient stores details required to interact with a specific service API implemented by a provider.
 // Generally, you'll acquire these by calling the appropriate `New` method on a ProviderClient.
@@ -16,6 +25,21 @@ type ServiceClient struct {
 	// the API version and, like Endpoint, MUST end with a / if set. If not set, the Endpoint is used
 	// as-is, instead.
 	ResourceBase string
+
+	// Type is the service type of the API, as it appears in the service catalog (e.g. "compute").
+	// It's reported to the ProviderClient's Interceptors.
+	Type string
+
+	// Context, if set, is attached to every request issued through this ServiceClient that doesn't
+	// already carry its own RequestOpts.Context. Use WithContext to obtain a bound copy.
+	Context context.Context
+
+	// Microversion, if set, requests a specific microversion of the service's API, e.g. "2.53" for
+	// Compute. It's sent with every request in the header that the service expects, as determined by
+	// Type, so requests fail with ErrMicroversionWithoutType if Type is empty. Use WithMicroversion
+	// to obtain a copy that requests a different one, and utils.GetSupportedMicroversions to find
+	// out which ones the service supports.
+	Microversion string
 }
 
 // ResourceBaseURL returns the base URL of any resources used by this service. It MUST end with a /.
@@ -30,3 +54,130 @@ func (client *ServiceClient) ResourceBaseURL() string {
 func (client *ServiceClient) ServiceURL(parts ...string) string {
 	return client.ResourceBaseURL() + strings.Join(parts, "/")
 }
+
+// WithContext returns a shallow copy of the ServiceClient whose requests, pagers and status waiters
+// are bound to ctx. The underlying ProviderClient is shared with the original.
+//
+//   ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+//   defer cancel()
+//   server, err := servers.Get(client.WithContext(ctx), id).Extract()
+func (client *ServiceClient) WithContext(ctx context.Context) *ServiceClient {
+	c := *client
+	c.Context = ctx
+	return &c
+}
+
+// WithMicroversion returns a shallow copy of the ServiceClient that requests the given microversion
+// of the service's API. The underlying ProviderClient is shared with the original.
+//
+//   server, err := servers.Get(client.WithMicroversion("2.26"), id).Extract()
+//   fmt.Println(server.Tags)
+func (client *ServiceClient) WithMicroversion(microversion string) *ServiceClient {
+	c := *client
+	c.Microversion = microversion
+	return &c
+}
+
+// microversionHeaders returns the headers that request microversion from a service of the given
+// type. Services that predate the common OpenStack-API-Version header are sent their own header
+// too.
+func microversionHeaders(serviceType, microversion string) map[string]string {
+	switch serviceType {
+	case "compute":
+		return map[string]string{
+			"X-OpenStack-Nova-API-Version": microversion,
+			"OpenStack-API-Version":        "compute " + microversion,
+		}
+	case "volume", "volumev2", "volumev3", "block-storage":
+		return map[string]string{"OpenStack-API-Version": "volume " + microversion}
+	case "baremetal":
+		return map[string]string{"X-OpenStack-Ironic-API-Version": microversion}
+	case "share", "sharev2", "shared-file-system":
+		return map[string]string{"X-OpenStack-Manila-API-Version": microversion}
+	}
+	return map[string]string{"OpenStack-API-Version": serviceType + " " + microversion}
+}
+
+// requestOpts returns a copy of opts with the ServiceClient's Context, Type and Microversion filled
+// in, if the caller hasn't provided values of their own.
+func (client *ServiceClient) requestOpts(opts *RequestOpts) (*RequestOpts, error) {
+	o := RequestOpts{}
+	if opts != nil {
+		o = *opts
+	}
+	if o.Context == nil {
+		o.Context = client.Context
+	}
+	if o.ServiceType == "" {
+		o.ServiceType = client.Type
+	}
+	if client.Microversion != "" {
+		if client.Type == "" {
+			return nil, ErrMicroversionWithoutType
+		}
+		headers := make(map[string]string, len(o.MoreHeaders)+2)
+		for k, v := range microversionHeaders(client.Type, client.Microversion) {
+			headers[k] = v
+		}
+		for k, v := range o.MoreHeaders {
+			headers[k] = v
+		}
+		o.MoreHeaders = headers
+	}
+	return &o, nil
+}
+
+// Request performs an HTTP request through the underlying ProviderClient, bound to the
+// ServiceClient's Context, tagged with its Type and requesting its Microversion.
+func (client *ServiceClient) Request(method, url string, options RequestOpts) (*http.Response, error) {
+	opts, err := client.requestOpts(&options)
+	if err != nil {
+		return nil, err
+	}
+	return client.ProviderClient.Request(method, url, *opts)
+}
+
+// Get calls ProviderClient.Get bound to the ServiceClient's Context.
+func (client *ServiceClient) Get(url string, JSONResponse *interface{}, opts *RequestOpts) (*http.Response, error) {
+	opts, err := client.requestOpts(opts)
+	if err != nil {
+		return nil, err
+	}
+	return client.ProviderClient.Get(url, JSONResponse, opts)
+}
+
+// Post calls ProviderClient.Post bound to the ServiceClient's Context.
+func (client *ServiceClient) Post(url string, body interface{}, JSONResponse *interface{}, opts *RequestOpts) (*http.Response, error) {
+	opts, err := client.requestOpts(opts)
+	if err != nil {
+		return nil, err
+	}
+	return client.ProviderClient.Post(url, body, JSONResponse, opts)
+}
+
+// Put calls ProviderClient.Put bound to the ServiceClient's Context.
+func (client *ServiceClient) Put(url string, body interface{}, JSONResponse *interface{}, opts *RequestOpts) (*http.Response, error) {
+	opts, err := client.requestOpts(opts)
+	if err != nil {
+		return nil, err
+	}
+	return client.ProviderClient.Put(url, body, JSONResponse, opts)
+}
+
+// Patch calls ProviderClient.Patch bound to the ServiceClient's Context.
+func (client *ServiceClient) Patch(url string, JSONBody interface{}, JSONResponse *interface{}, opts *RequestOpts) (*http.Response, error) {
+	opts, err := client.requestOpts(opts)
+	if err != nil {
+		return nil, err
+	}
+	return client.ProviderClient.Patch(url, JSONBody, JSONResponse, opts)
+}
+
+// Delete calls ProviderClient.Delete bound to the ServiceClient's Context.
+func (client *ServiceClient) Delete(url string, opts *RequestOpts) (*http.Response, error) {
+	opts, err := client.requestOpts(opts)
+	if err != nil {
+		return nil, err
+	}
+	return client.ProviderClient.Delete(url, opts)
+}
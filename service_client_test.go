@@ -1,6 +1,8 @@
 package gophercloud
 
 import (
+	"context"
+	"net/http"
 	"testing"
 
 	th "github.com/rackspace/gophercloud/testhelper"
@@ -12,3 +14,88 @@ func TestServiceURL(t *testing.T) {
 	actual := c.ServiceURL("more", "parts", "here")
 	th.CheckEquals(t, expected, actual)
 }
+
+func TestWithContext(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	c := &ServiceClient{ProviderClient: &ProviderClient{}, Endpoint: th.Endpoint()}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	bound := c.WithContext(ctx)
+	th.CheckEquals(t, ctx, bound.Context)
+	th.CheckEquals(t, c.ProviderClient, bound.ProviderClient)
+	if c.Context != nil {
+		t.Errorf("Expected the original ServiceClient to be left unbound")
+	}
+
+	_, err := bound.Get(c.ServiceURL("resource"), nil, nil)
+	th.AssertNoErr(t, err)
+
+	cancel()
+	_, err = bound.Get(c.ServiceURL("resource"), nil, nil)
+	th.AssertErr(t, err)
+
+	_, err = c.Get(c.ServiceURL("resource"), nil, nil)
+	th.AssertNoErr(t, err)
+}
+
+func TestWithMicroversion(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	var headers http.Header
+	th.Mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		headers = r.Header
+		w.WriteHeader(http.StatusOK)
+	})
+
+	c := &ServiceClient{ProviderClient: &ProviderClient{}, Endpoint: th.Endpoint(), Type: "compute"}
+
+	_, err := c.Get(c.ServiceURL("resource"), nil, nil)
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "", headers.Get("X-OpenStack-Nova-API-Version"))
+	th.CheckEquals(t, "", headers.Get("OpenStack-API-Version"))
+
+	bound := c.WithMicroversion("2.26")
+	th.CheckEquals(t, "", c.Microversion)
+	_, err = bound.Get(c.ServiceURL("resource"), nil, nil)
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "2.26", headers.Get("X-OpenStack-Nova-API-Version"))
+	th.CheckEquals(t, "compute 2.26", headers.Get("OpenStack-API-Version"))
+
+	// Headers set by the caller take precedence.
+	_, err = bound.Get(c.ServiceURL("resource"), nil, &RequestOpts{
+		MoreHeaders: map[string]string{"X-OpenStack-Nova-API-Version": "2.1"},
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "2.1", headers.Get("X-OpenStack-Nova-API-Version"))
+
+	volume := &ServiceClient{ProviderClient: &ProviderClient{}, Endpoint: th.Endpoint(), Type: "volumev3", Microversion: "3.50"}
+	_, err = volume.Get(volume.ServiceURL("resource"), nil, nil)
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "volume 3.50", headers.Get("OpenStack-API-Version"))
+}
+
+func TestWithMicroversionWithoutType(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	var requests int
+	th.Mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusOK)
+	})
+
+	c := (&ServiceClient{ProviderClient: &ProviderClient{}, Endpoint: th.Endpoint()}).WithMicroversion("2.6")
+
+	_, err := c.Get(c.ServiceURL("resource"), nil, nil)
+	th.CheckEquals(t, ErrMicroversionWithoutType, err)
+	_, err = c.Request("DELETE", c.ServiceURL("resource"), RequestOpts{})
+	th.CheckEquals(t, ErrMicroversionWithoutType, err)
+	th.CheckEquals(t, 0, requests)
+}
@@ -0,0 +1,132 @@
+package gophercloud
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// StoredToken is a token saved to a TokenStore, together with everything needed to use it without
+// contacting the identity service again.
+type StoredToken struct {
+	// ID is the token itself.
+	ID string `json:"id"`
+
+	// ExpiresAt is the time at which the identity service stops accepting the token.
+	ExpiresAt time.Time `json:"expires_at"`
+
+	// IdentityVersion identifies the version of the identity API that issued the token, and
+	// therefore the format of Catalog.
+	IdentityVersion string `json:"identity_version"`
+
+	// IdentityEndpoint is the versioned identity endpoint that issued the token.
+	IdentityEndpoint string `json:"identity_endpoint"`
+
+	// Catalog is the service catalog that was returned with the token, encoded as JSON.
+	Catalog json.RawMessage `json:"catalog"`
+}
+
+// TokenStore persists tokens so that they can be shared between processes, such as successive
+// invocations of a command-line tool. Set AuthOptions.TokenStore to enable it.
+//
+// A TokenStore is a cache: a provider that fails to load or save a token simply authenticates as it
+// would without one.
+type TokenStore interface {
+	// Load returns the token saved under key, or nil if there's none.
+	Load(key string) (*StoredToken, error)
+
+	// Save saves token under key, replacing any token that was saved there before.
+	Save(key string, token *StoredToken) error
+
+	// Delete forgets the token saved under key, if any.
+	Delete(key string) error
+}
+
+// TokenStoreKey identifies the tokens issued by identityEndpoint for the user and scope described
+// by options. Credentials aren't part of the key.
+func TokenStoreKey(identityEndpoint string, options AuthOptions) string {
+	return strings.Join([]string{
+		identityEndpoint,
+		options.UserID,
+		options.Username,
+		options.DomainID,
+		options.DomainName,
+		options.TenantID,
+		options.TenantName,
+		options.ApplicationCredentialID,
+		options.ApplicationCredentialName,
+	}, "\n")
+}
+
+// FileTokenStore is a TokenStore that keeps each token in its own file within Dir. The files are
+// only readable by their owner, since anyone who can read a token can act on its user's behalf.
+type FileTokenStore struct {
+	// Dir is the directory holding the tokens. It's created when the first token is saved.
+	Dir string
+}
+
+func (s *FileTokenStore) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(s.Dir, hex.EncodeToString(sum[:])+".json")
+}
+
+// Load reads the token saved under key. It returns nil if there's none.
+func (s *FileTokenStore) Load(key string) (*StoredToken, error) {
+	b, err := ioutil.ReadFile(s.path(key))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var token StoredToken
+	if err := json.Unmarshal(b, &token); err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+// Save writes token to the file for key. The file is replaced atomically, so that concurrent
+// processes never observe a partially written token.
+func (s *FileTokenStore) Save(key string, token *StoredToken) error {
+	b, err := json.Marshal(token)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(s.Dir, 0700); err != nil {
+		return err
+	}
+
+	// ioutil.TempFile creates the file with mode 0600.
+	f, err := ioutil.TempFile(s.Dir, ".token")
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(b)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), s.path(key))
+	}
+	if err != nil {
+		os.Remove(f.Name())
+	}
+	return err
+}
+
+// Delete removes the file for key, if it exists.
+func (s *FileTokenStore) Delete(key string) error {
+	err := os.Remove(s.path(key))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
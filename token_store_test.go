@@ -0,0 +1,69 @@
+package gophercloud
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	th "github.com/rackspace/gophercloud/testhelper"
+)
+
+func TestFileTokenStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gophercloud-tokens")
+	th.AssertNoErr(t, err)
+	defer os.RemoveAll(dir)
+
+	store := &FileTokenStore{Dir: dir + "/tokens"}
+	key := TokenStoreKey("https://identity.example.com/", AuthOptions{Username: "me", TenantName: "project"})
+
+	token, err := store.Load(key)
+	th.AssertNoErr(t, err)
+	if token != nil {
+		t.Fatalf("Expected no token, got %#v", token)
+	}
+
+	expires := time.Date(2999, 1, 1, 0, 0, 0, 0, time.UTC)
+	err = store.Save(key, &StoredToken{
+		ID:               "1234",
+		ExpiresAt:        expires,
+		IdentityVersion:  "v3.0",
+		IdentityEndpoint: "https://identity.example.com/v3/",
+		Catalog:          []byte(`{"Entries":[]}`),
+	})
+	th.AssertNoErr(t, err)
+
+	info, err := os.Stat(store.path(key))
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, os.FileMode(0600), info.Mode().Perm())
+
+	token, err = store.Load(key)
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "1234", token.ID)
+	th.CheckEquals(t, true, expires.Equal(token.ExpiresAt))
+	th.CheckEquals(t, "https://identity.example.com/v3/", token.IdentityEndpoint)
+	th.CheckEquals(t, `{"Entries":[]}`, string(token.Catalog))
+
+	th.AssertNoErr(t, store.Delete(key))
+	th.AssertNoErr(t, store.Delete(key))
+	token, err = store.Load(key)
+	th.AssertNoErr(t, err)
+	if token != nil {
+		t.Fatalf("Expected the token to be deleted, got %#v", token)
+	}
+}
+
+func TestTokenStoreKey(t *testing.T) {
+	options := AuthOptions{Username: "me", Password: "secret", TenantName: "project"}
+	key := TokenStoreKey("https://identity.example.com/", options)
+
+	changed := options
+	changed.Password = "another"
+	th.CheckEquals(t, key, TokenStoreKey("https://identity.example.com/", changed))
+
+	changed = options
+	changed.TenantName = "another"
+	if key == TokenStoreKey("https://identity.example.com/", changed) {
+		t.Errorf("Expected different scopes to have different keys")
+	}
+}
@@ -1,6 +1,7 @@
 package gophercloud
 
 import (
+	"context"
 	"errors"
 	"net/url"
 	"path/filepath"
@@ -12,14 +13,30 @@ import (
 // It usually does this to wait for a resource to transition to a certain state.
 // Resource packages will wrap this in a more convenient function that's
 // specific to a certain resource, but it can also be useful on its own.
+// To wait for a resource's status, with more control over polling, use a
+// Waiter instead.
 func WaitFor(timeout int, predicate func() (bool, error)) error {
-	start := time.Now().Second()
+	return WaitForContext(context.Background(), timeout, predicate)
+}
+
+// WaitForContext behaves like WaitFor, but stops polling and returns ctx.Err()
+// as soon as ctx is cancelled or its deadline passes. A nil ctx never expires.
+func WaitForContext(ctx context.Context, timeout int, predicate func() (bool, error)) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	start := time.Now()
 	for {
 		// Force a 1s sleep
-		time.Sleep(1 * time.Second)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(1 * time.Second):
+		}
 
 		// If a timeout is set, and that's been exceeded, shut it down
-		if timeout >= 0 && time.Now().Second()-start >= timeout {
+		if timeout >= 0 && time.Since(start) >= time.Duration(timeout)*time.Second {
 			return errors.New("A timeout occurred")
 		}
 
@@ -1,6 +1,7 @@
 package gophercloud
 
 import (
+	"context"
 	"os"
 	"path/filepath"
 	"strings"
@@ -16,6 +17,19 @@ func TestWaitFor(t *testing.T) {
 	th.CheckNoErr(t, err)
 }
 
+func TestWaitForContextCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	calls := 0
+	err := WaitForContext(ctx, 5, func() (bool, error) {
+		calls++
+		return false, nil
+	})
+	th.CheckEquals(t, context.Canceled, err)
+	th.CheckEquals(t, 0, calls)
+}
+
 func TestNormalizeURL(t *testing.T) {
 	urls := []string{
 		"NoSlashAtEnd",
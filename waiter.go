@@ -0,0 +1,182 @@
+package gophercloud
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+const (
+	// DefaultWaitInterval is used when Waiter.Interval is unset.
+	DefaultWaitInterval = 1 * time.Second
+
+	// DefaultWaitMaxInterval is used when Waiter.MaxInterval is unset.
+	DefaultWaitMaxInterval = 30 * time.Second
+)
+
+// StatusFunc reports the current status of a resource that's being waited for. Resource packages
+// provide one for each kind of resource that has a status, e.g. servers.StatusFunc.
+type StatusFunc func() (string, error)
+
+// WaitTimeoutError is returned by Waiter.Wait when the resource doesn't reach a target status in
+// time.
+type WaitTimeoutError struct {
+	Timeout time.Duration
+	Target  []string
+
+	// Status is the last status that was observed, if any.
+	Status string
+}
+
+func (err *WaitTimeoutError) Error() string {
+	return fmt.Sprintf("A timeout occurred after %s waiting for status %v; the last status was %q",
+		err.Timeout, err.Target, err.Status)
+}
+
+// FailedStatusError is returned by Waiter.Wait when the resource enters one of the Failure
+// statuses, such as a server going into ERROR while it's being built.
+type FailedStatusError struct {
+	Status string
+	Target []string
+}
+
+func (err *FailedStatusError) Error() string {
+	return fmt.Sprintf("Resource entered status %q while waiting for status %v", err.Status, err.Target)
+}
+
+/*
+Waiter polls a resource until it reaches one of the Target statuses, enters one of the Failure
+statuses, or the Timeout expires. For example, to wait up to ten minutes for a server to be built,
+checking every two seconds at first and then less and less often:
+
+  w := gophercloud.Waiter{
+    Target:   []string{"ACTIVE"},
+    Failure:  []string{"ERROR"},
+    Interval: 2 * time.Second,
+    Backoff:  1.5,
+    Timeout:  10 * time.Minute,
+  }
+  err := w.Wait(ctx, servers.StatusFunc(client, id))
+
+Most resource packages also provide a WaitForStatus function that fills in a Waiter for the common
+case.
+*/
+type Waiter struct {
+	// Target lists the statuses that end the wait successfully.
+	Target []string
+
+	// Failure lists the statuses that end the wait with a FailedStatusError. Target statuses take
+	// precedence, so it's safe to include the resource's usual error states even when waiting for
+	// one of them.
+	Failure []string
+
+	// UntilDeleted ends the wait successfully once the resource no longer exists, i.e. when the
+	// StatusFunc fails with a 404 Not Found error.
+	UntilDeleted bool
+
+	// Interval is the delay before each poll. Defaults to DefaultWaitInterval.
+	Interval time.Duration
+
+	// Backoff multiplies the interval after each poll. Values of 1 or less keep it constant.
+	Backoff float64
+
+	// MaxInterval caps the interval as Backoff increases it. Defaults to DefaultWaitMaxInterval.
+	MaxInterval time.Duration
+
+	// Timeout limits how long to wait. Zero or negative values wait until the context passed to
+	// Wait is done.
+	Timeout time.Duration
+
+	// Progress, if set, is called with the status observed by each poll and the time that has
+	// passed since the wait began.
+	Progress func(status string, elapsed time.Duration)
+}
+
+// WaitSeconds behaves like Wait, but takes its timeout in seconds with the meaning that WaitFor
+// gives it, which the WaitForStatus functions of the resource packages keep: a negative secs
+// waits until ctx is done, and a secs of 0 times out without polling at all. It overrides
+// w.Timeout.
+func (w Waiter) WaitSeconds(ctx context.Context, secs int, status StatusFunc) error {
+	if secs == 0 {
+		return &WaitTimeoutError{Target: w.Target}
+	}
+
+	w.Timeout = 0
+	if secs > 0 {
+		w.Timeout = time.Duration(secs) * time.Second
+	}
+	return w.Wait(ctx, status)
+}
+
+// Wait polls status until the resource reaches one of the target statuses. It returns early if ctx
+// is cancelled or its deadline passes, or if status fails with an error other than the 404 that
+// UntilDeleted expects. A nil ctx never expires.
+func (w *Waiter) Wait(ctx context.Context, status StatusFunc) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	interval := w.Interval
+	if interval <= 0 {
+		interval = DefaultWaitInterval
+	}
+	maxInterval := w.MaxInterval
+	if maxInterval <= 0 {
+		maxInterval = DefaultWaitMaxInterval
+	}
+
+	start := time.Now()
+	var deadline <-chan time.Time
+	if w.Timeout > 0 {
+		timer := time.NewTimer(w.Timeout)
+		defer timer.Stop()
+		deadline = timer.C
+	}
+
+	var current string
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-deadline:
+			return &WaitTimeoutError{Timeout: w.Timeout, Target: w.Target, Status: current}
+		case <-time.After(interval):
+		}
+
+		s, err := status()
+		if err != nil {
+			if w.UntilDeleted && IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		current = s
+
+		if w.Progress != nil {
+			w.Progress(current, time.Since(start))
+		}
+
+		if containsString(w.Target, current) {
+			return nil
+		}
+		if containsString(w.Failure, current) {
+			return &FailedStatusError{Status: current, Target: w.Target}
+		}
+
+		if w.Backoff > 1 {
+			interval = time.Duration(float64(interval) * w.Backoff)
+			if interval > maxInterval {
+				interval = maxInterval
+			}
+		}
+	}
+}
+
+func containsString(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
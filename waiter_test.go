@@ -0,0 +1,150 @@
+package gophercloud
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	th "github.com/rackspace/gophercloud/testhelper"
+)
+
+// statusSequence returns a StatusFunc that reports each of statuses in turn, then the last one
+// forever, along with the number of times it has been called.
+func statusSequence(statuses ...string) (StatusFunc, *int) {
+	calls := 0
+	return func() (string, error) {
+		i := calls
+		if i >= len(statuses) {
+			i = len(statuses) - 1
+		}
+		calls++
+		return statuses[i], nil
+	}, &calls
+}
+
+func TestWaiterReachesTarget(t *testing.T) {
+	status, _ := statusSequence("BUILD", "BUILD", "ACTIVE")
+
+	var seen []string
+	w := Waiter{
+		Target:   []string{"ACTIVE"},
+		Failure:  []string{"ERROR"},
+		Interval: time.Millisecond,
+		Progress: func(status string, elapsed time.Duration) {
+			seen = append(seen, status)
+		},
+	}
+	th.AssertNoErr(t, w.Wait(context.Background(), status))
+	th.CheckDeepEquals(t, []string{"BUILD", "BUILD", "ACTIVE"}, seen)
+}
+
+func TestWaiterFailsFast(t *testing.T) {
+	status, _ := statusSequence("BUILD", "ERROR", "ACTIVE")
+
+	w := Waiter{Target: []string{"ACTIVE"}, Failure: []string{"ERROR"}, Interval: time.Millisecond}
+	err := w.Wait(context.Background(), status)
+
+	casted, ok := err.(*FailedStatusError)
+	if !ok {
+		t.Fatalf("Expected a FailedStatusError, got %#v", err)
+	}
+	th.CheckEquals(t, "ERROR", casted.Status)
+
+	// Waiting for the failure status itself succeeds.
+	status, _ = statusSequence("BUILD", "ERROR")
+	w.Target = []string{"ERROR"}
+	th.AssertNoErr(t, w.Wait(context.Background(), status))
+}
+
+func TestWaiterTimeout(t *testing.T) {
+	status, _ := statusSequence("BUILD")
+
+	w := Waiter{Target: []string{"ACTIVE"}, Interval: time.Millisecond, Timeout: 20 * time.Millisecond}
+	err := w.Wait(context.Background(), status)
+
+	casted, ok := err.(*WaitTimeoutError)
+	if !ok {
+		t.Fatalf("Expected a WaitTimeoutError, got %#v", err)
+	}
+	th.CheckEquals(t, "BUILD", casted.Status)
+}
+
+func TestWaitSecondsZeroTimesOutImmediately(t *testing.T) {
+	status, calls := statusSequence("BUILD")
+
+	w := Waiter{Target: []string{"ACTIVE"}, Interval: time.Millisecond}
+	err := w.WaitSeconds(context.Background(), 0, status)
+
+	if _, ok := err.(*WaitTimeoutError); !ok {
+		t.Fatalf("Expected a WaitTimeoutError, got %#v", err)
+	}
+	th.CheckEquals(t, 0, *calls)
+}
+
+func TestWaitSecondsNegativeWaitsForContext(t *testing.T) {
+	status, _ := statusSequence("BUILD")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	w := Waiter{Target: []string{"ACTIVE"}, Interval: time.Millisecond}
+	err := w.WaitSeconds(ctx, -1, status)
+	th.CheckEquals(t, context.DeadlineExceeded, err)
+}
+
+func TestWaiterUntilDeleted(t *testing.T) {
+	calls := 0
+	status := func() (string, error) {
+		calls++
+		if calls == 3 {
+			return "", &NotFoundError{UnexpectedResponseCodeError{Actual: 404}}
+		}
+		return "DELETING", nil
+	}
+
+	w := Waiter{UntilDeleted: true, Interval: time.Millisecond}
+	th.AssertNoErr(t, w.Wait(context.Background(), status))
+	th.CheckEquals(t, 3, calls)
+
+	// Without UntilDeleted, a missing resource is an error.
+	calls = 0
+	w.UntilDeleted = false
+	err := w.Wait(context.Background(), status)
+	th.CheckEquals(t, true, IsNotFound(err))
+}
+
+func TestWaiterReturnsStatusErrors(t *testing.T) {
+	w := Waiter{Target: []string{"ACTIVE"}, Interval: time.Millisecond}
+	err := w.Wait(context.Background(), func() (string, error) {
+		return "", fmt.Errorf("connection refused")
+	})
+	th.CheckEquals(t, "connection refused", err.Error())
+}
+
+func TestWaiterBackoff(t *testing.T) {
+	status, calls := statusSequence("BUILD")
+
+	w := Waiter{
+		Target:      []string{"ACTIVE"},
+		Interval:    10 * time.Millisecond,
+		Backoff:     10,
+		MaxInterval: time.Hour,
+		Timeout:     250 * time.Millisecond,
+	}
+	_, ok := w.Wait(context.Background(), status).(*WaitTimeoutError)
+	th.CheckEquals(t, true, ok)
+
+	// Polls happen after 10ms and 110ms; the next one would be due after 1.11s.
+	th.CheckEquals(t, 2, *calls)
+}
+
+func TestWaiterContextCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	status, calls := statusSequence("BUILD", "ACTIVE")
+	w := Waiter{Target: []string{"ACTIVE"}, Interval: time.Millisecond}
+	th.CheckEquals(t, context.Canceled, w.Wait(ctx, status))
+	th.CheckEquals(t, 0, *calls)
+}